@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"fmt"
+	"html"
+	"os"
+	"unicode/utf8"
+
+	"github.com/juststeveking/scout/internal/config"
+	"github.com/juststeveking/scout/internal/monitor"
+	"github.com/spf13/cobra"
+)
+
+var (
+	badgeAll    bool
+	badgeLabel  string
+	badgeOutput string
+)
+
+// Badge colors, matching shields.io
+const (
+	badgeGreen  = "#4c1"
+	badgeYellow = "#dfb317"
+	badgeRed    = "#e05d44"
+	badgeGrey   = "#9f9f9f"
+)
+
+var badgeCmd = &cobra.Command{
+	Use:   "badge [service]",
+	Short: "Render a status badge as SVG",
+	Long: `Check a service once and print a shields.io style SVG badge showing
+its status, for embedding in a README or a static status page. With --all,
+every enabled service is checked and the badge summarizes them.
+
+Examples:
+  scout badge api-prod > api.svg
+  scout badge --all --label "api status" --output status.svg`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if badgeAll == (len(args) == 1) {
+			return fmt.Errorf("specify a service or --all")
+		}
+
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+
+		label := "status"
+		if !badgeAll {
+			service := cfg.FindService(args[0])
+			if service == nil {
+				return fmt.Errorf("service '%s' not found", args[0])
+			}
+			cfg.Services = []config.Service{*service}
+			label = service.Name
+		}
+		if badgeLabel != "" {
+			label = badgeLabel
+		}
+
+		results, err := monitor.RunOnce(cmd.Context(), cfg)
+		if err != nil {
+			return fmt.Errorf("failed to create monitor: %w", err)
+		}
+		if len(results) == 0 {
+			return fmt.Errorf("no enabled services to check")
+		}
+
+		message, color := badgeStatus(results)
+		svg := renderBadge(label, message, color)
+
+		if badgeOutput == "" {
+			fmt.Print(svg)
+			return nil
+		}
+		if err := os.WriteFile(badgeOutput, []byte(svg), 0644); err != nil {
+			return fmt.Errorf("failed to write badge: %w", err)
+		}
+		return nil
+	},
+}
+
+// badgeStatus returns a badge's message and color for a check round: a
+// single service's status, or a summary across several
+func badgeStatus(results []monitor.Result) (string, string) {
+	var healthy, unhealthy int
+	for _, result := range results {
+		switch result.Status {
+		case monitor.StatusHealthy:
+			healthy++
+		case monitor.StatusUnhealthy:
+			unhealthy++
+		}
+	}
+
+	if len(results) == 1 {
+		switch {
+		case healthy == 1:
+			return "up", badgeGreen
+		case unhealthy == 1:
+			return "down", badgeRed
+		default:
+			return "unknown", badgeGrey
+		}
+	}
+
+	switch {
+	case healthy == len(results):
+		return "operational", badgeGreen
+	case unhealthy == 0:
+		return "degraded", badgeYellow
+	default:
+		return fmt.Sprintf("%d of %d down", unhealthy, len(results)), badgeRed
+	}
+}
+
+// renderBadge renders a flat two-part badge. Text widths are estimated
+// from the character count since the viewer's font metrics aren't known.
+func renderBadge(label, message, color string) string {
+	textWidth := func(s string) int { return utf8.RuneCountInString(s)*7 + 10 }
+	labelWidth, messageWidth := textWidth(label), textWidth(message)
+	width := labelWidth + messageWidth
+	label, message = html.EscapeString(label), html.EscapeString(message)
+
+	return fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="%[1]d" height="20" role="img" aria-label="%[4]s: %[5]s">
+  <title>%[4]s: %[5]s</title>
+  <linearGradient id="s" x2="0" y2="100%%">
+    <stop offset="0" stop-color="#bbb" stop-opacity=".1"/>
+    <stop offset="1" stop-opacity=".1"/>
+  </linearGradient>
+  <clipPath id="r"><rect width="%[1]d" height="20" rx="3" fill="#fff"/></clipPath>
+  <g clip-path="url(#r)">
+    <rect width="%[2]d" height="20" fill="#555"/>
+    <rect x="%[2]d" width="%[3]d" height="20" fill="%[6]s"/>
+    <rect width="%[1]d" height="20" fill="url(#s)"/>
+  </g>
+  <g fill="#fff" text-anchor="middle" font-family="Verdana,Geneva,DejaVu Sans,sans-serif" font-size="11">
+    <text x="%[7]d" y="15" fill="#010101" fill-opacity=".3">%[4]s</text>
+    <text x="%[7]d" y="14">%[4]s</text>
+    <text x="%[8]d" y="15" fill="#010101" fill-opacity=".3">%[5]s</text>
+    <text x="%[8]d" y="14">%[5]s</text>
+  </g>
+</svg>
+`, width, labelWidth, messageWidth, label, message, color, labelWidth/2, labelWidth+messageWidth/2)
+}
+
+func init() {
+	badgeCmd.Flags().BoolVar(&badgeAll, "all", false, "summarize every enabled service in one badge")
+	badgeCmd.Flags().StringVar(&badgeLabel, "label", "", `left-hand text (default: the service name, or "status" with --all)`)
+	badgeCmd.Flags().StringVarP(&badgeOutput, "output", "o", "", "write the SVG to this file instead of stdout")
+	rootCmd.AddCommand(badgeCmd)
+}
@@ -0,0 +1,285 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juststeveking/scout/internal/config"
+	"github.com/juststeveking/scout/internal/monitor"
+	"github.com/juststeveking/scout/internal/notify"
+	"github.com/spf13/cobra"
+)
+
+// doctorCheck is one line of the doctor checklist
+type doctorCheck struct {
+	name string
+	err  error
+	hint string // Remediation shown when the check fails
+}
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Diagnose common configuration problems",
+	Long: `Check that the config file exists and parses, durations are valid, each
+service URL is well formed and resolves, and desktop notifications can be
+shown. Exits non-zero when any check fails.
+
+Examples:
+  scout doctor`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		checks := runDoctor(cmd.Context())
+
+		failed := 0
+		for _, check := range checks {
+			if check.err == nil {
+				fmt.Printf("✓ %s\n", check.name)
+				continue
+			}
+			failed++
+			fmt.Printf("✗ %s: %v\n", check.name, check.err)
+			if check.hint != "" {
+				fmt.Printf("    → %s\n", check.hint)
+			}
+		}
+
+		fmt.Println()
+		if failed > 0 {
+			return fmt.Errorf("%d of %d check(s) failed", failed, len(checks))
+		}
+		fmt.Println("Everything looks good!")
+		return nil
+	},
+}
+
+// runDoctor runs every diagnostic, stopping early only when the config
+// can't be loaded
+func runDoctor(ctx context.Context) []doctorCheck {
+	var checks []doctorCheck
+
+	configPath, err := config.GetConfigPath()
+	if err == nil {
+		_, err = os.Stat(configPath)
+	}
+	checks = append(checks, doctorCheck{
+		name: fmt.Sprintf("Config file exists (%s)", configPath),
+		err:  err,
+		hint: "run 'scout init' to create one",
+	})
+
+	cfg, err := config.LoadConfig()
+	checks = append(checks, doctorCheck{
+		name: "Config parses",
+		err:  err,
+		hint: "fix the YAML error above, or run 'scout init --force' to start over",
+	})
+	if err != nil {
+		return checks
+	}
+
+	timeout, err := parseDoctorDuration(cfg.Timeout)
+	checks = append(checks, doctorCheck{
+		name: "Timeout is valid",
+		err:  err,
+		hint: `use a Go duration such as "5s" or "500ms"`,
+	})
+	_, err = parseDoctorDuration(cfg.CheckInterval)
+	checks = append(checks, doctorCheck{
+		name: "Check interval is valid",
+		err:  err,
+		hint: `use a Go duration such as "30s" or "1m"`,
+	})
+	if cfg.DialTimeout != "" {
+		_, err = time.ParseDuration(cfg.DialTimeout)
+		checks = append(checks, doctorCheck{
+			name: "Dial timeout is valid",
+			err:  err,
+			hint: `use a Go duration such as "1s", or remove dial_timeout`,
+		})
+	}
+	if cfg.Jitter != "" {
+		_, err = time.ParseDuration(cfg.Jitter)
+		checks = append(checks, doctorCheck{
+			name: "Jitter is valid",
+			err:  err,
+			hint: `use a Go duration such as "2s", or remove jitter`,
+		})
+	}
+	if cfg.CheckingDelay != "" {
+		_, err = time.ParseDuration(cfg.CheckingDelay)
+		checks = append(checks, doctorCheck{
+			name: "Checking delay is valid",
+			err:  err,
+			hint: `use a Go duration such as "300ms", or remove checking_delay`,
+		})
+	}
+
+	groupNames := make([]string, 0, len(cfg.Groups))
+	for name := range cfg.Groups {
+		groupNames = append(groupNames, name)
+	}
+	sort.Strings(groupNames)
+	for _, name := range groupNames {
+		checks = append(checks, doctorCheck{
+			name: fmt.Sprintf("Group %s quorum is valid", name),
+			err:  groupQuorumError(cfg, name),
+			hint: "set quorum between 1 and the number of services in the group, or omit it for a majority",
+		})
+	}
+
+	// NewMonitor validates the notification templates and urgencies
+	mon, err := monitor.NewMonitor(cfg)
+	if err == nil {
+		mon.Close()
+	}
+	checks = append(checks, doctorCheck{
+		name: "Notification settings are valid",
+		err:  err,
+		hint: "check the notifications block for template syntax and urgency values",
+	})
+
+	if cfg.Notifications.IsEnabled() {
+		checks = append(checks, doctorCheck{
+			name: "Desktop notifications available",
+			err:  notify.CheckBackend(cfg.Notifications.FailureSound),
+			hint: "install the missing tool, or set notifications.enabled: false",
+		})
+	}
+
+	if timeout <= 0 {
+		timeout, _ = time.ParseDuration(config.DefaultTimeout)
+	}
+	for _, service := range cfg.Services {
+		if !service.IsEnabled() {
+			continue
+		}
+
+		if service.Type == "command" {
+			checks = append(checks, commandChecks(service, cfg.AllowExec)...)
+			continue
+		}
+
+		host, err := serviceHost(service)
+		checks = append(checks, doctorCheck{
+			name: fmt.Sprintf("%s: URL is valid", service.Name),
+			err:  err,
+			hint: `use a full URL such as "https://api.example.com", or host:port for tcp services`,
+		})
+		if err != nil || net.ParseIP(host) != nil {
+			continue
+		}
+
+		// socks5h proxies resolve hostnames on the far side
+		if strings.HasPrefix(service.Proxy, "socks5h://") {
+			continue
+		}
+
+		lookupCtx, cancel := context.WithTimeout(ctx, timeout)
+		_, err = net.DefaultResolver.LookupHost(lookupCtx, host)
+		cancel()
+		checks = append(checks, doctorCheck{
+			name: fmt.Sprintf("%s: %s resolves", service.Name, host),
+			err:  err,
+			hint: "check the hostname for typos, or your DNS and VPN settings",
+		})
+	}
+
+	return checks
+}
+
+// commandChecks verifies a command service's program can be found and
+// that command checks are allowed to run
+func commandChecks(service config.Service, allowed bool) []doctorCheck {
+	var err error
+	if len(service.Command) == 0 {
+		err = fmt.Errorf("no command configured")
+	} else {
+		_, err = exec.LookPath(service.Command[0])
+	}
+	checks := []doctorCheck{{
+		name: fmt.Sprintf("%s: command is runnable", service.Name),
+		err:  err,
+		hint: "set command to a program on your PATH and its arguments, e.g. [pg_isready, -h, db.internal]",
+	}}
+
+	if !allowed {
+		checks = append(checks, doctorCheck{
+			name: fmt.Sprintf("%s: command checks allowed", service.Name),
+			err:  fmt.Errorf("command checks are disabled"),
+			hint: "run scout with --allow-exec to let command services run",
+		})
+	}
+	return checks
+}
+
+// parseDoctorDuration parses a required, positive duration
+func parseDoctorDuration(value string) (time.Duration, error) {
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("must be positive, got %s", value)
+	}
+	return d, nil
+}
+
+// serviceHost validates a service's URL and returns the host it connects to
+func serviceHost(service config.Service) (string, error) {
+	checkerType := service.CheckerType()
+	switch checkerType {
+	case "tcp", "dns", "tls":
+		host, port := monitor.SplitServiceAddress(service.URL)
+		if host == "" || strings.ContainsAny(host, "/ ") {
+			return "", fmt.Errorf("%q is not a valid address", service.URL)
+		}
+		if checkerType == "tcp" && port == "" {
+			return "", fmt.Errorf("missing port in address %q", service.URL)
+		}
+		return host, nil
+	}
+
+	raw, err := monitor.RequestURL(service)
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme == "" || u.Hostname() == "" {
+		return "", fmt.Errorf("%q is missing a scheme or host", raw)
+	}
+	return u.Hostname(), nil
+}
+
+// groupQuorumError reports a group with no members or a quorum its members
+// can never meet
+func groupQuorumError(cfg *config.Config, name string) error {
+	members := 0
+	for _, service := range cfg.Services {
+		if service.Group == name && service.IsEnabled() {
+			members++
+		}
+	}
+
+	quorum := cfg.Groups[name].Quorum
+	switch {
+	case members == 0:
+		return fmt.Errorf("no enabled services are in group %q", name)
+	case quorum < 0 || quorum > members:
+		return fmt.Errorf("quorum %d is outside 1–%d", quorum, members)
+	}
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/juststeveking/scout/internal/monitor"
+)
+
+// runHeadless prints check results to stdout until the monitor stops
+func runHeadless(mon *monitor.Monitor, events bool) error {
+	encoder := json.NewEncoder(os.Stdout)
+
+	for result := range mon.Results() {
+		// Only report completed checks
+		if result.Status == monitor.StatusChecking || result.Status == monitor.StatusThrottled {
+			continue
+		}
+
+		if events {
+			if err := encoder.Encode(monitor.NewEvent(result)); err != nil {
+				return fmt.Errorf("failed to write event: %w", err)
+			}
+			continue
+		}
+
+		fmt.Println(formatResultLine(result))
+	}
+
+	return nil
+}
+
+// formatResultLine formats a check result as a human-readable log line
+func formatResultLine(result monitor.Result) string {
+	icon := "?"
+	switch result.Status {
+	case monitor.StatusHealthy:
+		icon = "✓"
+	case monitor.StatusUnhealthy:
+		icon = "✗"
+	}
+
+	line := fmt.Sprintf("%s %s %s %s (%s)",
+		result.CheckedAt.Format(time.RFC3339),
+		icon,
+		result.ServiceName,
+		result.Status,
+		formatLatency(result.ResponseTime),
+	)
+	if result.Message != "" {
+		line += " " + result.Message
+	}
+	if result.Error != nil {
+		line += ": " + result.Error.Error()
+	}
+	return line
+}
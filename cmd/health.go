@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/juststeveking/scout/internal/monitor"
+)
+
+// serveHealth serves Scout's own liveness and readiness over HTTP at addr:
+// /healthz succeeds while the monitor loop is running, and /readyz once it
+// is also producing results
+func serveHealth(ctx context.Context, addr string, mon *monitor.Monitor) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen for health checks: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", probeHandler(mon.Alive))
+	mux.HandleFunc("/readyz", probeHandler(mon.Ready))
+
+	server := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+
+	go func() {
+		if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			log.Printf("health server failed: %v", err)
+		}
+	}()
+
+	return nil
+}
+
+// probeHandler responds 200 when check passes and 503 otherwise
+func probeHandler(check func(time.Time) bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		if !check(time.Now()) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprintln(w, "not ok")
+			return
+		}
+		fmt.Fprintln(w, "ok")
+	}
+}
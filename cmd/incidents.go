@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/juststeveking/scout/internal/config"
+	"github.com/juststeveking/scout/internal/monitor"
+	"github.com/spf13/cobra"
+)
+
+var incidentsLimit int
+
+var incidentsCmd = &cobra.Command{
+	Use:   "incidents [service]",
+	Short: "Show past incidents and recovery times",
+	Long: `List the incidents recorded while scout was running, from a service
+turning unhealthy until it recovered, with each service's incident count,
+longest incident, and mean time to recovery (MTTR).
+
+Incidents still ongoing when scout exits aren't recorded.
+
+Examples:
+  scout incidents
+  scout incidents api-prod --limit 50`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path, err := config.GetIncidentsPath()
+		if err != nil {
+			return err
+		}
+
+		incidents, err := monitor.LoadIncidents(path)
+		if err != nil {
+			return err
+		}
+
+		if len(args) == 1 {
+			var filtered []monitor.Incident
+			for _, incident := range incidents {
+				if incident.ServiceName == args[0] {
+					filtered = append(filtered, incident)
+				}
+			}
+			incidents = filtered
+		}
+
+		if len(incidents) == 0 {
+			fmt.Println("No incidents recorded.")
+			return nil
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "SERVICE\tINCIDENTS\tLONGEST\tMTTR\tLAST")
+		for _, s := range monitor.SummarizeIncidents(incidents) {
+			fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n",
+				s.ServiceName,
+				s.Count,
+				formatIncidentDuration(s.Longest),
+				formatIncidentDuration(s.MTTR),
+				s.Last.Started.Local().Format("2006-01-02 15:04"),
+			)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+
+		// Most recent incidents, newest first
+		fmt.Println()
+		w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "STARTED\tSERVICE\tDURATION\tMESSAGE")
+		for i := len(incidents) - 1; i >= 0 && len(incidents)-i <= incidentsLimit; i-- {
+			incident := incidents[i]
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+				incident.Started.Local().Format("2006-01-02 15:04:05"),
+				incident.ServiceName,
+				formatIncidentDuration(incident.Duration(incident.Ended)),
+				incident.Message,
+			)
+		}
+		return w.Flush()
+	},
+}
+
+func init() {
+	incidentsCmd.Flags().IntVarP(&incidentsLimit, "limit", "n", 20, "number of recent incidents to list")
+	rootCmd.AddCommand(incidentsCmd)
+}
+
+// formatIncidentDuration formats a downtime to the second, e.g. "4m12s"
+func formatIncidentDuration(d time.Duration) string {
+	if d < time.Second {
+		return "<1s"
+	}
+	return d.Round(time.Second).String()
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/juststeveking/scout/internal/config"
+	"github.com/juststeveking/scout/internal/monitor"
+	"github.com/juststeveking/scout/internal/notify"
+	"github.com/spf13/cobra"
+)
+
+var notifyTestBackend string
+
+// notifyBackends are the notification backends scout can deliver through
+var notifyBackends = []string{"desktop"}
+
+var notifyTestCmd = &cobra.Command{
+	Use:   "notify:test",
+	Short: "Send sample notifications to verify delivery",
+	Long: `Send a sample failure and recovery notification through each notification
+backend, using the configured templates and urgencies, and report whether
+each was delivered. Notifications are sent even when they're disabled in the
+config, so delivery can be verified before turning them on. Exits non-zero
+when any notification fails.
+
+Examples:
+  scout notify:test
+  scout notify:test --backend desktop`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		backends := notifyBackends
+		if notifyTestBackend != "" {
+			if !slices.Contains(notifyBackends, notifyTestBackend) {
+				return fmt.Errorf("unknown backend %q (available: %s)", notifyTestBackend, strings.Join(notifyBackends, ", "))
+			}
+			backends = []string{notifyTestBackend}
+		}
+
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+
+		notifier, err := monitor.NewNotifier(cfg)
+		if err != nil {
+			return err
+		}
+		if !cfg.Notifications.IsEnabled() {
+			fmt.Println("Notifications are disabled in the config; sending samples anyway")
+			fmt.Println()
+		}
+		notifier.SetEnabled(true)
+
+		now := time.Now()
+		failure := notify.CheckResult{
+			ServiceName:  "scout-test",
+			Status:       notify.Status(monitor.StatusUnhealthy),
+			ResponseTime: 250 * time.Millisecond,
+			StatusCode:   http.StatusServiceUnavailable,
+			Error:        errors.New("sample failure sent by scout notify:test"),
+			CheckedAt:    now,
+			Message:      "Expected 200, got 503",
+		}
+		recovery := notify.CheckResult{
+			ServiceName:  "scout-test",
+			Status:       notify.Status(monitor.StatusHealthy),
+			ResponseTime: 120 * time.Millisecond,
+			StatusCode:   http.StatusOK,
+			CheckedAt:    now,
+		}
+
+		failed := 0
+		for _, backend := range backends {
+			fmt.Println(backend)
+			for _, sample := range []struct {
+				name string
+				send func() error
+			}{
+				{"Failure notification", func() error { return notifier.NotifyFailure(failure) }},
+				{"Recovery notification", func() error { return notifier.NotifyRecovery(recovery) }},
+			} {
+				if err := sample.send(); err != nil {
+					failed++
+					fmt.Printf("  ✗ %s: %v\n", sample.name, err)
+					continue
+				}
+				fmt.Printf("  ✓ %s sent\n", sample.name)
+			}
+		}
+
+		if failed > 0 {
+			fmt.Println()
+			return fmt.Errorf("%d notification(s) failed", failed)
+		}
+		return nil
+	},
+}
+
+func init() {
+	notifyTestCmd.Flags().StringVar(&notifyTestBackend, "backend", "", "only test this backend (default: all)")
+	rootCmd.AddCommand(notifyTestCmd)
+}
@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/term"
+	"github.com/juststeveking/scout/internal/monitor"
+	"github.com/juststeveking/scout/internal/tui"
+)
+
+// snapshotWidth is used when stdout isn't a terminal
+const snapshotWidth = 120
+
+// runOnce runs a single check round and prints one rendered dashboard frame
+func runOnce(ctx context.Context, mon *monitor.Monitor, color bool) error {
+	defer mon.Close()
+
+	results := mon.CheckAllOnce(ctx)
+
+	width, _, err := term.GetSize(os.Stdout.Fd())
+	if err != nil || width <= 0 {
+		width = snapshotWidth
+	}
+
+	frame := tui.RenderSnapshot(mon, results, width)
+	if !color {
+		frame = ansi.Strip(frame)
+	}
+
+	fmt.Println(frame)
+	return nil
+}
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/juststeveking/scout/internal/config"
+	"github.com/juststeveking/scout/internal/monitor"
+	"github.com/spf13/cobra"
+)
+
+var onelineNoColor bool
+
+var onelineCmd = &cobra.Command{
+	Use:   "oneline",
+	Short: "Print a one-line status summary",
+	Long: `Run a single check round and print a terse summary suitable for shell
+prompts and tmux status bars, e.g. "scout: 8/10 ✓ 1⚠ 1✗".
+
+✓ counts healthy services, ⚠ services that could not be checked, and ✗
+unhealthy services.
+
+Examples:
+  scout oneline
+  set -g status-right '#(scout oneline --no-color)'`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+
+		results, err := monitor.RunOnce(cmd.Context(), cfg)
+		if err != nil {
+			return fmt.Errorf("failed to create monitor: %w", err)
+		}
+
+		fmt.Println(formatOneline(results, !onelineNoColor))
+		return nil
+	},
+}
+
+func init() {
+	onelineCmd.Flags().BoolVar(&onelineNoColor, "no-color", false, "disable ANSI colors")
+	rootCmd.AddCommand(onelineCmd)
+}
+
+// formatOneline summarizes a check round as "scout: 8/10 ✓ 1⚠ 1✗"
+func formatOneline(results []monitor.Result, color bool) string {
+	var healthy, unknown, unhealthy int
+	for _, result := range results {
+		switch result.Status {
+		case monitor.StatusHealthy:
+			healthy++
+		case monitor.StatusUnhealthy:
+			unhealthy++
+		default:
+			unknown++
+		}
+	}
+
+	return fmt.Sprintf("scout: %s %s %s",
+		paint(color, "32", fmt.Sprintf("%d/%d ✓", healthy, len(results))),
+		paint(color, "33", fmt.Sprintf("%d⚠", unknown)),
+		paint(color, "31", fmt.Sprintf("%d✗", unhealthy)),
+	)
+}
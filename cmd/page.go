@@ -0,0 +1,260 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"syscall"
+	"time"
+
+	"github.com/juststeveking/scout/internal/config"
+	"github.com/juststeveking/scout/internal/monitor"
+	"github.com/spf13/cobra"
+)
+
+var (
+	pageOutput string
+	pageWatch  bool
+)
+
+var pageCmd = &cobra.Command{
+	Use:   "page",
+	Short: "Render a static HTML status page",
+	Long: `Run a check round and write a self-contained HTML status page showing
+each service's status, latency, and when it was last checked, grouped into
+healthy and unhealthy services like the dashboard. The page has no external
+assets, so it can be published from any static host.
+
+With --watch, the page is regenerated every check interval until interrupted.
+
+Examples:
+  scout page --output status.html
+  scout page -o /var/www/status/index.html --watch`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+
+		if !pageWatch {
+			return writePage(cmd.Context(), cfg, pageOutput)
+		}
+
+		interval, err := time.ParseDuration(cfg.CheckInterval)
+		if err != nil || interval <= 0 {
+			return fmt.Errorf("invalid check interval %q", cfg.CheckInterval)
+		}
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			if err := writePage(ctx, cfg, pageOutput); err != nil {
+				return err
+			}
+			fmt.Printf("Wrote %s at %s (next in %s)\n", pageOutput, time.Now().Format("15:04:05"), interval)
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	},
+}
+
+// writePage checks every enabled service once and writes the status page,
+// replacing the file atomically so a web server never serves half a page
+func writePage(ctx context.Context, cfg *config.Config, path string) error {
+	results, err := monitor.RunOnce(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create monitor: %w", err)
+	}
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	if err := renderPage(&buf, cfg, results, time.Now()); err != nil {
+		return fmt.Errorf("failed to render page: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".scout-page-*")
+	if err != nil {
+		return fmt.Errorf("failed to write page: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(buf.Bytes()); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write page: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write page: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return fmt.Errorf("failed to write page: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write page: %w", err)
+	}
+	return nil
+}
+
+// pageService is a service's row on the status page
+type pageService struct {
+	Name      string
+	Status    string
+	Class     string
+	Latency   string
+	CheckedAt time.Time
+	Detail    string
+}
+
+// pageSection is a titled group of services on the status page
+type pageSection struct {
+	Title    string
+	Services []pageService
+}
+
+// renderPage renders a check round as a standalone HTML page. Services are
+// grouped by status and ordered by priority then name, as on the dashboard.
+func renderPage(w io.Writer, cfg *config.Config, results []monitor.Result, generated time.Time) error {
+	priorities := make(map[string]int, len(cfg.Services))
+	for _, service := range cfg.Services {
+		priorities[service.Name] = service.Priority
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		if priorities[results[i].ServiceName] != priorities[results[j].ServiceName] {
+			return priorities[results[i].ServiceName] > priorities[results[j].ServiceName]
+		}
+		return results[i].ServiceName < results[j].ServiceName
+	})
+
+	healthy := pageSection{Title: "Healthy"}
+	unhealthy := pageSection{Title: "Unhealthy"}
+	for _, result := range results {
+		row := pageService{
+			Name:      result.ServiceName,
+			Status:    string(result.Status),
+			Class:     "unknown",
+			Latency:   "—",
+			CheckedAt: result.CheckedAt,
+			Detail:    result.Message,
+		}
+		if result.ResponseTime > 0 {
+			row.Latency = formatLatency(result.ResponseTime)
+		}
+		if result.Error != nil {
+			row.Detail = result.Error.Error()
+		}
+
+		switch result.Status {
+		case monitor.StatusHealthy:
+			row.Class = "up"
+			healthy.Services = append(healthy.Services, row)
+		case monitor.StatusUnhealthy:
+			row.Class = "down"
+			unhealthy.Services = append(unhealthy.Services, row)
+		default:
+			unhealthy.Services = append(unhealthy.Services, row)
+		}
+	}
+
+	summary, class := "All systems operational", "up"
+	switch {
+	case len(results) == 0:
+		summary, class = "No services configured", "unknown"
+	case len(unhealthy.Services) > 0:
+		summary, class = fmt.Sprintf("%d of %d services unhealthy", len(unhealthy.Services), len(results)), "down"
+	}
+
+	var sections []pageSection
+	for _, section := range []pageSection{healthy, unhealthy} {
+		if len(section.Services) > 0 {
+			sections = append(sections, section)
+		}
+	}
+
+	return pageTemplate.Execute(w, struct {
+		Summary   string
+		Class     string
+		Sections  []pageSection
+		Generated time.Time
+	}{summary, class, sections, generated})
+}
+
+var pageTemplate = template.Must(template.New("page").Funcs(template.FuncMap{
+	"timestamp": func(t time.Time) string {
+		if t.IsZero() {
+			return "never"
+		}
+		return t.Format("2006-01-02 15:04:05 MST")
+	},
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Status</title>
+<style>
+  body { margin: 0; padding: 2rem 1rem; background: #f6f7f9; color: #1f2328; font: 15px/1.5 -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; }
+  main { max-width: 760px; margin: 0 auto; }
+  h1 { font-size: 1.5rem; margin: 0 0 1rem; }
+  h2 { font-size: 1rem; margin: 2rem 0 .5rem; color: #59636e; }
+  .summary { padding: 1rem 1.25rem; border-radius: 6px; color: #fff; font-weight: 600; }
+  .summary.up { background: #2da44e; }
+  .summary.down { background: #cf222e; }
+  .summary.unknown { background: #8c959f; }
+  ul { list-style: none; margin: 0; padding: 0; background: #fff; border: 1px solid #d1d9e0; border-radius: 6px; }
+  li { display: flex; flex-wrap: wrap; align-items: baseline; gap: .25rem 1rem; padding: .75rem 1.25rem; border-top: 1px solid #d1d9e0; }
+  li:first-child { border-top: 0; }
+  .name { flex: 1; font-weight: 600; }
+  .status { font-weight: 600; text-transform: capitalize; }
+  .status.up { color: #1a7f37; }
+  .status.down { color: #cf222e; }
+  .status.unknown { color: #59636e; }
+  .meta, .detail, footer { color: #59636e; font-size: .85rem; }
+  .detail { flex-basis: 100%; overflow-wrap: anywhere; }
+  footer { margin-top: 2rem; text-align: center; }
+</style>
+</head>
+<body>
+<main>
+  <h1>Status</h1>
+  <div class="summary {{.Class}}">{{.Summary}}</div>
+{{- range .Sections}}
+  <h2>{{.Title}} ({{len .Services}})</h2>
+  <ul>
+{{- range .Services}}
+    <li>
+      <span class="name">{{.Name}}</span>
+      <span class="meta">{{.Latency}} · checked {{timestamp .CheckedAt}}</span>
+      <span class="status {{.Class}}">{{.Status}}</span>
+{{- if .Detail}}
+      <span class="detail">{{.Detail}}</span>
+{{- end}}
+    </li>
+{{- end}}
+  </ul>
+{{- end}}
+  <footer>Generated by scout at {{timestamp .Generated}}</footer>
+</main>
+</body>
+</html>
+`))
+
+func init() {
+	pageCmd.Flags().StringVarP(&pageOutput, "output", "o", "status.html", "file to write the page to")
+	pageCmd.Flags().BoolVar(&pageWatch, "watch", false, "regenerate the page every check interval")
+	rootCmd.AddCommand(pageCmd)
+}
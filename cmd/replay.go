@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/juststeveking/scout/internal/config"
+	"github.com/juststeveking/scout/internal/monitor"
+	"github.com/juststeveking/scout/internal/tui"
+	"github.com/spf13/cobra"
+)
+
+var replaySpeed string
+
+var replayCmd = &cobra.Command{
+	Use:   "replay <events-file>",
+	Short: "Replay recorded check results through the dashboard",
+	Long: `Play back results recorded with 'scout --events' through the dashboard
+instead of running real checks, to revisit an incident's timeline or to
+demo the dashboard without live endpoints.
+
+Results keep their recorded timestamps and are spaced out by the recorded
+gaps, divided by --speed. Groups and display settings come from the config
+when there is one; adding services and re-checking are unavailable.
+
+Examples:
+  scout --events > events.jsonl
+  scout replay events.jsonl
+  scout replay events.jsonl --speed 10x`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		speed, err := parseSpeed(replaySpeed)
+		if err != nil {
+			return err
+		}
+
+		results, err := monitor.LoadEvents(args[0])
+		if err != nil {
+			return err
+		}
+		if len(results) == 0 {
+			return fmt.Errorf("no events in %s", args[0])
+		}
+
+		mon, err := monitor.NewMonitor(replayConfig(results))
+		if err != nil {
+			return fmt.Errorf("failed to create monitor: %w", err)
+		}
+
+		log.SetOutput(io.Discard)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		go mon.Replay(ctx, results, speed)
+
+		p := tea.NewProgram(tui.NewModel(mon, cancel), tea.WithAltScreen())
+		if _, err := p.Run(); err != nil {
+			return fmt.Errorf("failed to start TUI: %w", err)
+		}
+		return nil
+	},
+}
+
+// replayConfig returns the config to replay results with: the user's
+// config when it loads, limited to the recorded services, plus any recorded
+// services it doesn't define. Notifications are always disabled.
+func replayConfig(results []monitor.Result) *config.Config {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		cfg = &config.Config{
+			CheckInterval: config.DefaultCheckInterval,
+			Timeout:       config.DefaultTimeout,
+			RetryAttempts: config.DefaultRetryAttempts,
+		}
+	}
+
+	disabled := false
+	cfg.Notifications = config.Notifications{Enabled: &disabled}
+
+	var services []config.Service
+	seen := make(map[string]bool)
+	for _, result := range results {
+		if seen[result.ServiceName] {
+			continue
+		}
+		seen[result.ServiceName] = true
+
+		service := config.Service{Name: result.ServiceName}
+		if found := cfg.FindService(result.ServiceName); found != nil {
+			service = *found
+		}
+		services = append(services, service)
+	}
+	cfg.Services = services
+
+	return cfg
+}
+
+// parseSpeed parses a playback speed such as "10x", "10", or "0.5x"
+func parseSpeed(value string) (float64, error) {
+	speed, err := strconv.ParseFloat(strings.TrimSuffix(strings.ToLower(strings.TrimSpace(value)), "x"), 64)
+	if err != nil || speed <= 0 {
+		return 0, fmt.Errorf("invalid speed %q (expected a positive multiplier such as 10x)", value)
+	}
+	return speed, nil
+}
+
+func init() {
+	replayCmd.Flags().StringVar(&replaySpeed, "speed", "1x", `playback speed as a multiplier, e.g. "10x"`)
+	rootCmd.AddCommand(replayCmd)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"log"
 	"os"
 	"os/signal"
 	"syscall"
@@ -15,6 +17,22 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var (
+	logFile     string
+	watchConfig bool
+	noTUI       bool
+	emitEvents  bool
+	configDir   string
+	once        bool
+	noColor     bool
+	socketPath  string
+	healthAddr  string
+	themeName   string
+	noNotify    bool
+	profileName string
+	allowExec   bool
+)
+
 var rootCmd = &cobra.Command{
 	Use:   "scout",
 	Short: "Monitor the health of your services from the terminal",
@@ -47,39 +65,175 @@ you depend on during development.`,
 			return fmt.Errorf("no services configured (run 'scout service:add' to add one)")
 		}
 
+		if themeName != "" {
+			if err := tui.SetTheme(themeName); err != nil {
+				return err
+			}
+		}
+
 		// Create monitor
 		mon, err := monitor.NewMonitor(cfg)
 		if err != nil {
 			return fmt.Errorf("failed to create monitor: %w", err)
 		}
 
+		// Start with notifications muted regardless of config, leaving the
+		// config itself alone so saving it doesn't persist the flag; they
+		// can still be unmuted from the dashboard
+		if noNotify {
+			mon.SetNotificationsEnabled(false)
+		}
+
+		if once {
+			return runOnce(cmd.Context(), mon, !noColor)
+		}
+
+		// The event stream owns stdout, so it always runs without the TUI
+		headless := noTUI || emitEvents
+
+		// Log to a file if requested; stderr would corrupt the TUI
+		if logFile != "" {
+			f, err := tea.LogToFile(logFile, "scout")
+			if err != nil {
+				return fmt.Errorf("failed to open log file: %w", err)
+			}
+			defer f.Close()
+		} else if !headless {
+			log.SetOutput(io.Discard)
+		}
+
+		// Record incidents for `scout incidents`; monitoring works without it
+		if incidentsPath, err := config.GetIncidentsPath(); err == nil {
+			if err := mon.PersistIncidents(incidentsPath); err != nil {
+				log.Printf("Incidents won't be recorded: %v", err)
+			}
+		}
+
+		// Honor snoozes set with `scout service:snooze`, and keep those set
+		// from the dashboard across restarts
+		if snoozesPath, err := config.GetSnoozesPath(); err == nil {
+			if err := mon.PersistSnoozes(snoozesPath); err != nil {
+				log.Printf("Snoozes won't be saved: %v", err)
+			}
+		}
+
 		// Setup context with cancellation
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
-		// Handle OS signals
+		// Start monitoring in background
+		go mon.Start(ctx)
+
+		// Create TUI
+		var p *tea.Program
+		if !headless {
+			model := tui.NewModel(mon, cancel)
+			p = tea.NewProgram(model, tea.WithAltScreen())
+		}
+
+		// Handle OS signals, reloading the config on SIGHUP
 		sigChan := make(chan os.Signal, 1)
-		signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+		signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
+		defer signal.Stop(sigChan)
 		go func() {
-			<-sigChan
-			cancel()
+			for sig := range sigChan {
+				if sig == syscall.SIGHUP {
+					reloadConfig(mon, p)
+					continue
+				}
+				cancel()
+				return
+			}
 		}()
 
-		// Start monitoring in background
-		go mon.Start(ctx)
+		// Reload automatically when the config file changes
+		if watchConfig {
+			configPath, err := config.GetConfigPath()
+			if err != nil {
+				return err
+			}
+			if err := watchConfigFile(ctx, configPath, func() { reloadConfig(mon, p) }); err != nil {
+				return err
+			}
+		}
 
-		// Start TUI
-		model := tui.NewModel(mon, cancel)
-		p := tea.NewProgram(model, tea.WithAltScreen())
+		// Stream results to local clients over a Unix domain socket
+		if socketPath != "" {
+			if err := serveSocket(ctx, socketPath, mon); err != nil {
+				return err
+			}
+			defer os.Remove(socketPath)
+		}
+
+		// Report Scout's own liveness, e.g. to Kubernetes probes
+		if healthAddr != "" {
+			if err := serveHealth(ctx, healthAddr, mon); err != nil {
+				return err
+			}
+		}
+
+		if headless {
+			return runHeadless(mon, emitEvents)
+		}
 
-		if _, err := p.Run(); err != nil {
+		final, err := p.Run()
+		if err != nil {
 			return fmt.Errorf("failed to start TUI: %w", err)
 		}
+		if model, ok := final.(tui.Model); ok && model.ShutdownErr() != nil {
+			return fmt.Errorf("unsaved changes were lost: failed to save config: %w", model.ShutdownErr())
+		}
 
 		return nil
 	},
 }
 
+func init() {
+	cobra.OnInitialize(func() {
+		config.SetConfigDir(configDir)
+		config.SetProfile(profileName)
+		config.SetAllowExec(allowExec)
+	})
+	rootCmd.PersistentFlags().StringVar(&configDir, "config-dir", "", "merge services from every *.yml file in this directory (default: conf.d next to the config file)")
+	rootCmd.PersistentFlags().StringVar(&profileName, "profile", "", "apply this profile from the config's profiles section (e.g. prod or staging)")
+	rootCmd.PersistentFlags().BoolVar(&allowExec, "allow-exec", false, "allow services with type: command to run their commands")
+	rootCmd.PersistentFlags().BoolVar(&noNotify, "no-notify", false, "disable desktop notifications regardless of config")
+	rootCmd.Flags().StringVar(&logFile, "log-file", "", "write logs (e.g. config reloads) to this file")
+	rootCmd.Flags().BoolVarP(&watchConfig, "watch", "w", false, "reload the config automatically when it changes on disk")
+	rootCmd.Flags().BoolVar(&noTUI, "no-tui", false, "run without the dashboard, printing results to stdout")
+	rootCmd.Flags().BoolVar(&emitEvents, "events", false, "emit each check result as a JSON line on stdout (implies --no-tui)")
+	rootCmd.Flags().StringVar(&healthAddr, "health-addr", "", "serve /healthz and /readyz for Scout itself on this address (e.g. :8080)")
+	rootCmd.Flags().StringVar(&socketPath, "socket", "", "stream check results as JSON lines to clients of this Unix domain socket")
+	rootCmd.Flags().StringVar(&themeName, "theme", "", `dashboard theme: "default" or "colorblind"`)
+	rootCmd.Flags().BoolVar(&once, "once", false, "run one check round, print a single dashboard frame, and exit")
+	rootCmd.Flags().BoolVar(&noColor, "no-color", false, "disable ANSI colors in --once output")
+}
+
+// reloadConfig reloads the config file and applies it to the running monitor
+func reloadConfig(mon *monitor.Monitor, p *tea.Program) {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Printf("config reload failed: %v", err)
+		if p != nil {
+			p.Send(tui.ConfigReloadedMsg{Err: err})
+		}
+		return
+	}
+
+	summary, err := mon.Reload(cfg)
+	if err != nil {
+		log.Printf("config reload failed: %v", err)
+		if p != nil {
+			p.Send(tui.ConfigReloadedMsg{Err: err})
+		}
+		return
+	}
+	log.Printf("config reloaded: %s", summary)
+	if p != nil {
+		p.Send(tui.ConfigReloadedMsg{Summary: summary})
+	}
+}
+
 func Execute() {
 	if err := rootCmd.Execute(); err != nil {
 		os.Exit(1)
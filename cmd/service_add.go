@@ -21,6 +21,8 @@ var (
 	authUsername          string
 	authPassword          string
 	jsonAssertions        []string // Format: "path=value=operator" (e.g., "status=ok===")
+	addDryRun             bool
+	addFrom               string
 )
 
 var serviceAddCmd = &cobra.Command{
@@ -42,8 +44,24 @@ Examples:
   scout service:add --name api --url https://api.example.com --json-assertion status=ok===  --json-assertion uptime=0=>
   
   # TCP port check
-  scout service:add --name db --url db.example.com:5432 --type tcp`,
+  scout service:add --name db --url db.example.com:5432 --type tcp
+
+  # Preview the service without writing the config
+  scout service:add --name api --url https://api.example.com --dry-run
+
+  # Add many services from a CSV file with a header row, e.g.
+  #   name,url,type,health_endpoint,method,expected_status,group,headers
+  #   api,https://api.example.com,,/health,,,,X-Env=prod;X-Team=core
+  # (or a JSON/YAML list of services using the config file's fields)
+  scout service:add --from services.csv`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if addFrom != "" {
+			if serviceName != "" || serviceURL != "" {
+				return fmt.Errorf("--from can't be combined with --name or --url")
+			}
+			return addServicesFrom(addFrom, addDryRun)
+		}
+
 		// Validate required fields
 		if serviceName == "" {
 			return fmt.Errorf("service name is required (--name)")
@@ -102,12 +120,17 @@ Examples:
 			return err
 		}
 
+		configPath, _ := config.GetConfigPath()
+		if addDryRun {
+			fmt.Printf("[dry-run] would add service '%s' to %s\n\n", serviceName, configPath)
+			return printServiceYAML(service)
+		}
+
 		// Save config
 		if err := config.SaveConfig(cfg); err != nil {
 			return err
 		}
 
-		configPath, _ := config.GetConfigPath()
 		fmt.Printf("✓ Added service '%s' to %s\n", serviceName, configPath)
 
 		return nil
@@ -115,8 +138,8 @@ Examples:
 }
 
 func init() {
-	serviceAddCmd.Flags().StringVarP(&serviceName, "name", "n", "", "service name (required)")
-	serviceAddCmd.Flags().StringVarP(&serviceURL, "url", "u", "", "service URL (required)")
+	serviceAddCmd.Flags().StringVarP(&serviceName, "name", "n", "", "service name (required unless --from)")
+	serviceAddCmd.Flags().StringVarP(&serviceURL, "url", "u", "", "service URL (required unless --from)")
 	serviceAddCmd.Flags().StringVar(&serviceHealthEndpoint, "health-endpoint", "", "health check endpoint path")
 	serviceAddCmd.Flags().StringVar(&serviceMethod, "method", "GET", "HTTP method for health check")
 	serviceAddCmd.Flags().IntVar(&serviceExpectedStatus, "expected-status", 200, "expected HTTP status code")
@@ -128,12 +151,22 @@ func init() {
 	serviceAddCmd.Flags().StringVar(&authPassword, "auth-password", "", "password for basic authentication")
 	serviceAddCmd.Flags().StringSliceVar(&jsonAssertions, "json-assertion", nil, "JSON path assertion (format: path=value=operator, e.g., status=ok===)")
 
-	serviceAddCmd.MarkFlagRequired("name")
-	serviceAddCmd.MarkFlagRequired("url")
+	serviceAddCmd.Flags().BoolVar(&addDryRun, "dry-run", false, "validate and print the service that would be added without saving")
+	serviceAddCmd.Flags().StringVar(&addFrom, "from", "", "add every service listed in a CSV, JSON, or YAML file")
 
 	rootCmd.AddCommand(serviceAddCmd)
 }
 
+// printServiceYAML prints the YAML a service is saved as
+func printServiceYAML(service config.Service) error {
+	data, err := config.ServiceYAML(service)
+	if err != nil {
+		return err
+	}
+	fmt.Print(string(data))
+	return nil
+}
+
 // Helper functions
 func splitAssertionString(s string) []string {
 	parts := make([]string, 0)
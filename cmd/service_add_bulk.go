@@ -0,0 +1,232 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/juststeveking/scout/internal/config"
+	"gopkg.in/yaml.v3"
+)
+
+// bulkColumns are the columns accepted in a --from CSV file. Headers are
+// given as "Name=value" pairs separated by semicolons.
+var bulkColumns = []string{
+	"name", "url", "type", "health_endpoint", "method", "expected_status", "group",
+	"auth_type", "auth_token", "auth_username", "auth_password", "headers",
+}
+
+// bulkRow is a service read from a --from file, or the reason it couldn't be read
+type bulkRow struct {
+	label   string // How the row is referred to in output, e.g. "row 3"
+	service config.Service
+	err     error
+}
+
+// addServicesFrom adds every service defined in a CSV, JSON, or YAML file,
+// reporting each one's outcome and skipping invalid rows and duplicates
+func addServicesFrom(path string, dryRun bool) error {
+	rows, err := readServicesFile(path)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return fmt.Errorf("no services found in %s", path)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	added := 0
+	for _, row := range rows {
+		if row.err == nil {
+			row.err = validateBulkService(row.service)
+		}
+		if row.err != nil {
+			fmt.Printf("✗ %s: %v\n", row.label, row.err)
+			continue
+		}
+		if cfg.FindService(row.service.Name) != nil {
+			fmt.Printf("- %s: already exists, skipped\n", row.service.Name)
+			continue
+		}
+		if err := cfg.AddService(row.service); err != nil {
+			fmt.Printf("✗ %s: %v\n", row.label, err)
+			continue
+		}
+		fmt.Printf("✓ %s\n", row.service.Name)
+		added++
+	}
+
+	configPath, _ := config.GetConfigPath()
+	if dryRun {
+		fmt.Printf("\n[dry-run] would add %d of %d services to %s\n", added, len(rows), configPath)
+		return nil
+	}
+	if added == 0 {
+		fmt.Println("\nNo services added.")
+		return nil
+	}
+
+	if err := config.SaveConfig(cfg); err != nil {
+		return err
+	}
+	fmt.Printf("\nAdded %d of %d services to %s\n", added, len(rows), configPath)
+	return nil
+}
+
+// validateBulkService checks a service has a name and a usable address
+func validateBulkService(service config.Service) error {
+	if service.Name == "" {
+		return fmt.Errorf("service name is required")
+	}
+	if service.Type == "command" {
+		return nil
+	}
+	if service.URL == "" {
+		return fmt.Errorf("service URL is required")
+	}
+	_, err := serviceHost(service)
+	return err
+}
+
+// readServicesFile reads services from a CSV file, or from a JSON or YAML
+// list of services using the same fields as config.yml
+func readServicesFile(path string) ([]bulkRow, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open services file: %w", err)
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return readServicesCSV(f)
+	case ".json", ".yml", ".yaml":
+		// JSON is valid YAML, so both decode with the config's field names
+		var services []config.Service
+		if err := yaml.NewDecoder(f).Decode(&services); err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		rows := make([]bulkRow, len(services))
+		for i, service := range services {
+			rows[i] = bulkRow{label: fmt.Sprintf("entry %d", i+1), service: service}
+			if service.Name != "" {
+				rows[i].label = service.Name
+			}
+		}
+		return rows, nil
+	default:
+		return nil, fmt.Errorf("unsupported services file %q (expected .csv, .json, or .yml)", path)
+	}
+}
+
+// readServicesCSV reads services from CSV with a header row naming a subset
+// of bulkColumns, in any order
+func readServicesCSV(r io.Reader) ([]bulkRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !isBulkColumn(name) {
+			return nil, fmt.Errorf("unknown CSV column %q (expected %s)", name, strings.Join(bulkColumns, ", "))
+		}
+		columns[name] = i
+	}
+	if _, ok := columns["name"]; !ok {
+		return nil, fmt.Errorf("CSV header must include a name column")
+	}
+
+	var rows []bulkRow
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		label := fmt.Sprintf("row %d", line)
+		if err != nil {
+			rows = append(rows, bulkRow{label: label, err: err})
+			continue
+		}
+
+		get := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		service, err := csvService(get)
+		if service.Name != "" {
+			label = fmt.Sprintf("%s (%s)", label, service.Name)
+		}
+		rows = append(rows, bulkRow{label: label, service: service, err: err})
+	}
+	return rows, nil
+}
+
+// csvService builds a service from a CSV row's columns
+func csvService(get func(column string) string) (config.Service, error) {
+	service := config.Service{
+		Name:           get("name"),
+		URL:            get("url"),
+		Type:           get("type"),
+		HealthEndpoint: get("health_endpoint"),
+		Method:         get("method"),
+		Group:          get("group"),
+	}
+
+	if status := get("expected_status"); status != "" {
+		code, err := strconv.Atoi(status)
+		if err != nil {
+			return service, fmt.Errorf("invalid expected_status %q", status)
+		}
+		service.ExpectedStatus = code
+	}
+
+	if authType := get("auth_type"); authType != "" {
+		service.Auth = &config.Auth{
+			Type:     authType,
+			Token:    get("auth_token"),
+			Username: get("auth_username"),
+			Password: get("auth_password"),
+		}
+	}
+
+	if headers := get("headers"); headers != "" {
+		service.Headers = make(map[string]string)
+		for _, pair := range strings.Split(headers, ";") {
+			name, value, ok := strings.Cut(pair, "=")
+			if !ok || strings.TrimSpace(name) == "" {
+				return service, fmt.Errorf("invalid header %q (expected Name=value)", pair)
+			}
+			service.Headers[strings.TrimSpace(name)] = strings.TrimSpace(value)
+		}
+	}
+
+	return service, nil
+}
+
+// isBulkColumn reports whether name is an accepted CSV column
+func isBulkColumn(name string) bool {
+	for _, column := range bulkColumns {
+		if column == name {
+			return true
+		}
+	}
+	return false
+}
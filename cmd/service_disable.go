@@ -0,0 +1,22 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var serviceDisableCmd = &cobra.Command{
+	Use:   "service:disable <name>",
+	Short: "Stop checking a service without removing it",
+	Long: `Disable a service so scout skips it while keeping its configuration.
+
+Example:
+  scout service:disable api-prod`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setServiceEnabled(args[0], false)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(serviceDisableCmd)
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/juststeveking/scout/internal/config"
+	"github.com/spf13/cobra"
+)
+
+var serviceEnableCmd = &cobra.Command{
+	Use:   "service:enable <name>",
+	Short: "Enable checks for a disabled service",
+	Long: `Re-enable a service that was disabled with service:disable.
+
+Example:
+  scout service:enable api-prod`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setServiceEnabled(args[0], true)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(serviceEnableCmd)
+}
+
+// setServiceEnabled flips a service's enabled flag and saves the config
+func setServiceEnabled(serviceName string, enabled bool) error {
+	// Load existing config
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	if err := cfg.SetServiceEnabled(serviceName, enabled); err != nil {
+		return err
+	}
+
+	// Save config
+	if err := config.SaveConfig(cfg); err != nil {
+		return err
+	}
+
+	state := "Enabled"
+	if !enabled {
+		state = "Disabled"
+	}
+	fmt.Printf("✓ %s service '%s'\n", state, serviceName)
+
+	return nil
+}
@@ -2,15 +2,29 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/juststeveking/scout/internal/config"
+	"github.com/juststeveking/scout/internal/monitor"
 	"github.com/spf13/cobra"
 )
 
+var (
+	listDetailed bool
+	listNoColor  bool
+)
+
 var serviceListCmd = &cobra.Command{
 	Use:   "service:list",
 	Short: "List all configured services",
-	Long:  `Display all services currently configured in scout.`,
+	Long: `Display all services currently configured in scout.
+
+With --detailed, each enabled service is checked once and its live status,
+latency, and status code are shown next to its name.
+
+Examples:
+  scout service:list
+  scout service:list --detailed --no-color`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		cfg, err := config.LoadConfig()
 		if err != nil {
@@ -24,11 +38,31 @@ var serviceListCmd = &cobra.Command{
 			return nil
 		}
 
+		color := !listNoColor
+
+		// Check every enabled service once to annotate the list
+		results := make(map[string]monitor.Result)
+		if listDetailed {
+			checked, err := monitor.RunOnce(cmd.Context(), cfg)
+			if err != nil {
+				return fmt.Errorf("failed to create monitor: %w", err)
+			}
+			for _, result := range checked {
+				results[result.ServiceName] = result
+			}
+		}
+
 		fmt.Printf("Configured services (%d):\n\n", len(cfg.Services))
 
 		for _, service := range cfg.Services {
-			fmt.Printf("  • %s\n", service.Name)
-			fmt.Printf("    URL: %s", service.URL)
+			if !service.IsEnabled() {
+				fmt.Printf("  ◦ %s\n", paint(color, "2", service.Name+" (disabled)"))
+			} else if result, ok := results[service.Name]; ok {
+				fmt.Printf("  • %s  %s\n", service.Name, formatListStatus(result, color))
+			} else {
+				fmt.Printf("  • %s\n", service.Name)
+			}
+			fmt.Printf("    URL: %s", monitor.RedactURL(service.URL))
 
 			if service.HealthEndpoint != "" {
 				fmt.Printf("%s", service.HealthEndpoint)
@@ -50,6 +84,40 @@ var serviceListCmd = &cobra.Command{
 	},
 }
 
+// formatListStatus summarizes a check result as e.g. "✓ healthy  142ms  200"
+func formatListStatus(result monitor.Result, color bool) string {
+	icon, code := "⚠", "33"
+	switch result.Status {
+	case monitor.StatusHealthy:
+		icon, code = "✓", "32"
+	case monitor.StatusUnhealthy:
+		icon, code = "✗", "31"
+	}
+
+	parts := []string{paint(color, code, icon+" "+string(result.Status))}
+	if result.ResponseTime > 0 {
+		parts = append(parts, fmt.Sprintf("%dms", result.ResponseTime.Milliseconds()))
+	}
+	if result.StatusCode > 0 {
+		parts = append(parts, fmt.Sprint(result.StatusCode))
+	}
+	if result.Status != monitor.StatusHealthy && result.Message != "" {
+		parts = append(parts, paint(color, "2", result.Message))
+	}
+	return strings.Join(parts, "  ")
+}
+
+// paint wraps s in an ANSI SGR code when color is enabled
+func paint(color bool, code, s string) string {
+	if !color {
+		return s
+	}
+	return "\033[" + code + "m" + s + "\033[0m"
+}
+
 func init() {
+	serviceListCmd.Flags().BoolVar(&listDetailed, "detailed", false, "check each service once and show its live status")
+	serviceListCmd.Flags().BoolVar(&listDetailed, "status", false, "alias for --detailed")
+	serviceListCmd.Flags().BoolVar(&listNoColor, "no-color", false, "disable ANSI colors")
 	rootCmd.AddCommand(serviceListCmd)
 }
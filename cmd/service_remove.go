@@ -11,7 +11,8 @@ import (
 )
 
 var (
-	forceRemove bool
+	forceRemove  bool
+	removeDryRun bool
 )
 
 var serviceRemoveCmd = &cobra.Command{
@@ -21,7 +22,8 @@ var serviceRemoveCmd = &cobra.Command{
 
 Example:
   scout service:remove api-prod
-  scout service:remove redis --force`,
+  scout service:remove redis --force
+  scout service:remove redis --dry-run`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		serviceName := args[0]
@@ -32,6 +34,19 @@ Example:
 			return fmt.Errorf("failed to load config: %w", err)
 		}
 
+		if removeDryRun {
+			found := cfg.FindService(serviceName)
+			if found == nil {
+				return fmt.Errorf("service '%s' not found", serviceName)
+			}
+			path, err := config.ServicePath(*found)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("[dry-run] would remove service '%s' from %s\n\n", serviceName, path)
+			return printServiceYAML(*found)
+		}
+
 		// Confirm removal unless --force is used
 		if !forceRemove {
 			fmt.Printf("Remove service '%s'? (y/N): ", serviceName)
@@ -67,5 +82,6 @@ Example:
 
 func init() {
 	serviceRemoveCmd.Flags().BoolVarP(&forceRemove, "force", "f", false, "skip confirmation prompt")
+	serviceRemoveCmd.Flags().BoolVar(&removeDryRun, "dry-run", false, "print the service that would be removed without saving")
 	rootCmd.AddCommand(serviceRemoveCmd)
 }
@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/juststeveking/scout/internal/config"
+	"github.com/juststeveking/scout/internal/monitor"
+	"github.com/spf13/cobra"
+)
+
+var (
+	snoozeFor   time.Duration
+	snoozeClear bool
+)
+
+var serviceSnoozeCmd = &cobra.Command{
+	Use:   "service:snooze <name>",
+	Short: "Silence a service's notifications for a while",
+	Long: `Suppress a service's notifications until a deadline, for example during a
+deploy that's known to break it. The service is still checked and shown as
+snoozed on the dashboard, and notifications resume on their own once the
+snooze ends. A running scout picks the snooze up within a second, and it
+survives restarts.
+
+Examples:
+  scout service:snooze api-prod --for 1h
+  scout service:snooze api-prod --clear`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if snoozeClear == (snoozeFor > 0) {
+			return fmt.Errorf("specify a positive --for duration or --clear")
+		}
+
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+		serviceName := args[0]
+		if cfg.FindService(serviceName) == nil {
+			return fmt.Errorf("service '%s' not found", serviceName)
+		}
+
+		path, err := config.GetSnoozesPath()
+		if err != nil {
+			return err
+		}
+		snoozes, err := monitor.LoadSnoozes(path)
+		if err != nil {
+			return err
+		}
+
+		if snoozeClear {
+			delete(snoozes, serviceName)
+			if err := monitor.SaveSnoozes(path, snoozes); err != nil {
+				return err
+			}
+			fmt.Printf("✓ Notifications resumed for '%s'\n", serviceName)
+			return nil
+		}
+
+		until := time.Now().Add(snoozeFor)
+		snoozes[serviceName] = until
+		if err := monitor.SaveSnoozes(path, snoozes); err != nil {
+			return err
+		}
+		fmt.Printf("✓ Snoozed '%s' until %s\n", serviceName, until.Format("2006-01-02 15:04"))
+		return nil
+	},
+}
+
+func init() {
+	serviceSnoozeCmd.Flags().DurationVar(&snoozeFor, "for", 0, "how long to silence notifications, e.g. 1h or 30m")
+	serviceSnoozeCmd.Flags().BoolVar(&snoozeClear, "clear", false, "end an active snooze now")
+	rootCmd.AddCommand(serviceSnoozeCmd)
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/juststeveking/scout/internal/config"
+	"github.com/juststeveking/scout/internal/monitor"
+	"github.com/spf13/cobra"
+)
+
+var (
+	sloSamples  int
+	sloInterval time.Duration
+)
+
+var sloCmd = &cobra.Command{
+	Use:   "slo",
+	Short: "Summarize service level objectives",
+	Long: `Run a short burst of checks against every service with an slo block and
+report whether its availability and latency objectives are met.
+
+Examples:
+  scout slo
+  scout slo --samples 100 --interval 200ms`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if sloSamples < 1 {
+			return fmt.Errorf("samples must be at least 1")
+		}
+
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+
+		var services []config.Service
+		for _, s := range cfg.Services {
+			if s.IsEnabled() && s.SLO != nil {
+				services = append(services, s)
+			}
+		}
+		if len(services) == 0 {
+			return fmt.Errorf("no services with an slo block configured")
+		}
+
+		mon, err := monitor.NewMonitor(cfg)
+		if err != nil {
+			return fmt.Errorf("failed to create monitor: %w", err)
+		}
+		defer mon.Close()
+
+		fmt.Printf("Sampling %d service(s), %d checks each...\n\n", len(services), sloSamples)
+
+		samples := sampleServices(cmd.Context(), mon, services, sloSamples, sloInterval)
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "SERVICE\tSAMPLES\tAVAILABILITY\tLATENCY\tSTATUS")
+		missed := 0
+		for i, service := range services {
+			report := monitor.EvaluateSLO(*service.SLO, samples[i])
+
+			availability := "-"
+			if report.HasAvailability {
+				availability = fmt.Sprintf("%.2f%% / %g%%", report.Availability, report.AvailabilityTarget)
+			}
+			latency := "-"
+			if report.HasLatency {
+				latency = fmt.Sprintf("p%g %s / %s", report.Percentile, formatLatency(report.Latency), formatLatency(report.LatencyTarget))
+			}
+			status := "met"
+			if !report.Met() {
+				status = "missed"
+				missed++
+			}
+
+			fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n", service.Name, report.Samples, availability, latency, status)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+
+		if missed > 0 {
+			return fmt.Errorf("%d of %d service(s) missed their SLO", missed, len(services))
+		}
+		return nil
+	},
+}
+
+func init() {
+	sloCmd.Flags().IntVarP(&sloSamples, "samples", "s", 20, "number of checks to run per service")
+	sloCmd.Flags().DurationVar(&sloInterval, "interval", time.Second, "delay between checks")
+	rootCmd.AddCommand(sloCmd)
+}
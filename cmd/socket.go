@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"time"
+
+	"github.com/juststeveking/scout/internal/monitor"
+)
+
+// serveSocket streams completed check results as newline-delimited JSON
+// events to every client connected to a Unix domain socket at path
+func serveSocket(ctx context.Context, path string, mon *monitor.Monitor) error {
+	// Remove a stale socket left behind by a previous run, but never one a
+	// running scout is still serving
+	if info, err := os.Stat(path); err == nil && info.Mode()&os.ModeSocket != 0 {
+		conn, err := net.DialTimeout("unix", path, time.Second)
+		if err == nil {
+			conn.Close()
+			return fmt.Errorf("socket %s is already in use by another scout", path)
+		}
+		os.Remove(path)
+	}
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return fmt.Errorf("failed to listen on socket: %w", err)
+	}
+
+	go func() {
+		<-ctx.Done()
+		listener.Close()
+	}()
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Printf("socket accept failed: %v", err)
+				}
+				return
+			}
+			go streamEvents(ctx, conn, mon)
+		}
+	}()
+
+	return nil
+}
+
+// streamEvents writes results to a socket client until it disconnects
+func streamEvents(ctx context.Context, conn net.Conn, mon *monitor.Monitor) {
+	defer conn.Close()
+
+	results, unsubscribe := mon.Subscribe()
+	defer unsubscribe()
+
+	// Clients only read; a read returning means they disconnected
+	disconnected := make(chan struct{})
+	go func() {
+		defer close(disconnected)
+		buf := make([]byte, 512)
+		for {
+			if _, err := conn.Read(buf); err != nil {
+				return
+			}
+		}
+	}()
+
+	encoder := json.NewEncoder(conn)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-disconnected:
+			return
+		case result := <-results:
+			if err := encoder.Encode(monitor.NewEvent(result)); err != nil {
+				return
+			}
+		}
+	}
+}
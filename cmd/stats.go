@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/juststeveking/scout/internal/config"
+	"github.com/juststeveking/scout/internal/monitor"
+	"github.com/spf13/cobra"
+)
+
+var (
+	statsSamples  int
+	statsInterval time.Duration
+)
+
+var statsCmd = &cobra.Command{
+	Use:   "stats [service]",
+	Short: "Print latency and uptime statistics",
+	Long: `Run a short burst of checks against your services and print a one-shot
+report of min/avg/p50/p95/p99/max latency and uptime percentage.
+
+Examples:
+  scout stats
+  scout stats api-prod --samples 50 --interval 500ms`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if statsSamples < 1 {
+			return fmt.Errorf("samples must be at least 1")
+		}
+
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+
+		var services []config.Service
+		for _, s := range cfg.Services {
+			if s.IsEnabled() {
+				services = append(services, s)
+			}
+		}
+		if len(args) == 1 {
+			services = nil
+			for _, s := range cfg.Services {
+				if s.Name == args[0] {
+					services = append(services, s)
+					break
+				}
+			}
+			if len(services) == 0 {
+				return fmt.Errorf("service '%s' not found", args[0])
+			}
+		}
+
+		if len(services) == 0 {
+			return fmt.Errorf("no services configured (run 'scout service:add' to add one)")
+		}
+
+		mon, err := monitor.NewMonitor(cfg)
+		if err != nil {
+			return fmt.Errorf("failed to create monitor: %w", err)
+		}
+		defer mon.Close()
+
+		fmt.Printf("Sampling %d service(s), %d checks each...\n\n", len(services), statsSamples)
+
+		samples := sampleServices(cmd.Context(), mon, services, statsSamples, statsInterval)
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "SERVICE\tSAMPLES\tMIN\tAVG\tP50\tP95\tP99\tMAX\tUPTIME")
+		for i, service := range services {
+			s := monitor.ComputeStats(service.Name, samples[i])
+			fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\t%s\t%s\t%.1f%%\n",
+				s.ServiceName,
+				s.Samples,
+				formatLatency(s.Min),
+				formatLatency(s.Avg),
+				formatLatency(s.P50),
+				formatLatency(s.P95),
+				formatLatency(s.P99),
+				formatLatency(s.Max),
+				s.Uptime,
+			)
+		}
+		return w.Flush()
+	},
+}
+
+func init() {
+	statsCmd.Flags().IntVarP(&statsSamples, "samples", "s", 10, "number of checks to run per service")
+	statsCmd.Flags().DurationVar(&statsInterval, "interval", time.Second, "delay between checks")
+	rootCmd.AddCommand(statsCmd)
+}
+
+// sampleServices samples each service concurrently, returning results in service order
+func sampleServices(ctx context.Context, mon *monitor.Monitor, services []config.Service, samples int, interval time.Duration) [][]monitor.Result {
+	results := make([][]monitor.Result, len(services))
+	var wg sync.WaitGroup
+	for i, service := range services {
+		wg.Add(1)
+		go func(i int, svc config.Service) {
+			defer wg.Done()
+			results[i] = sampleService(ctx, mon, svc, samples, interval)
+		}(i, service)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// sampleService runs a burst of checks against a service
+func sampleService(ctx context.Context, mon *monitor.Monitor, service config.Service, samples int, interval time.Duration) []monitor.Result {
+	results := make([]monitor.Result, 0, samples)
+	for i := 0; i < samples; i++ {
+		if i > 0 {
+			select {
+			case <-time.After(interval):
+			case <-ctx.Done():
+				return results
+			}
+		}
+		results = append(results, mon.CheckOnce(ctx, service))
+	}
+
+	return results
+}
+
+// formatLatency formats a duration for table output
+func formatLatency(d time.Duration) string {
+	if d < time.Millisecond {
+		return fmt.Sprintf("%dµs", d.Microseconds())
+	}
+	if d < time.Second {
+		return fmt.Sprintf("%dms", d.Milliseconds())
+	}
+	return fmt.Sprintf("%.2fs", d.Seconds())
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -81,12 +82,445 @@ func TestConfigOperations(t *testing.T) {
 	}
 }
 
-func TestResolveEnv(t *testing.T) {
-	os.Setenv("TEST_VAR", "world")
-	defer os.Unsetenv("TEST_VAR")
+func TestLoadConfigDirectory(t *testing.T) {
+	tmpHome := t.TempDir()
+	t.Setenv("HOME", tmpHome)
 
-	val := ResolveEnv("hello ${TEST_VAR}")
-	if val != "hello world" {
-		t.Errorf("Expected 'hello world', got '%s'", val)
+	if err := InitConfig(false); err != nil {
+		t.Fatalf("InitConfig failed: %v", err)
+	}
+
+	confDir := filepath.Join(tmpHome, ".config", "scout", "conf.d")
+	if err := os.MkdirAll(confDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	teamA := filepath.Join(confDir, "team-a.yml")
+	if err := os.WriteFile(teamA, []byte("check_interval: 1s\nservices:\n  - name: a-api\n    url: http://a.example.com\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	teamB := filepath.Join(confDir, "team-b.yml")
+	if err := os.WriteFile(teamB, []byte("services:\n  - name: b-api\n    url: http://b.example.com\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+	if len(cfg.Services) != 3 {
+		t.Fatalf("Expected 3 merged services, got %d", len(cfg.Services))
+	}
+	if cfg.CheckInterval != DefaultCheckInterval {
+		t.Errorf("Expected globals from the base file, got check_interval %s", cfg.CheckInterval)
+	}
+	if cfg.Services[1].Source != teamA || cfg.Services[2].Source != teamB {
+		t.Errorf("Expected services to record their source files, got %q and %q", cfg.Services[1].Source, cfg.Services[2].Source)
+	}
+
+	// Saving writes each service back to the file it came from
+	if err := cfg.RemoveService("a-api"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.AddService(Service{Name: "base-api", URL: "http://base.example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := SaveConfig(cfg); err != nil {
+		t.Fatalf("SaveConfig failed: %v", err)
+	}
+	cfg, err = LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig after save failed: %v", err)
+	}
+	if len(cfg.Services) != 3 || cfg.Services[2].Name != "b-api" {
+		t.Errorf("Expected services to round-trip to their files, got %+v", cfg.Services)
+	}
+
+	// Duplicate names across files are rejected
+	if err := os.WriteFile(teamA, []byte("services:\n  - name: b-api\n    url: http://dup.example.com\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadConfig(); err == nil {
+		t.Error("Expected duplicate service names across files to fail")
+	}
+
+	// An explicit config directory must exist
+	SetConfigDir(filepath.Join(tmpHome, "missing"))
+	defer SetConfigDir("")
+	if _, err := LoadConfig(); err == nil {
+		t.Error("Expected a missing --config-dir to fail")
+	}
+}
+
+func TestLoadConfigProfile(t *testing.T) {
+	tmpHome := t.TempDir()
+	t.Setenv("HOME", tmpHome)
+
+	configPath := filepath.Join(tmpHome, ".config", "scout", "config.yml")
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	content := `check_interval: 30s
+timeout: 5s
+retry_attempts: 3
+services:
+  - name: website
+    url: https://example.com
+    group: public
+  - name: internal-tool
+    url: https://tool.example.com
+    group: internal
+profiles:
+  staging:
+    check_interval: 10s
+    retry_attempts: 1
+    include_groups: [public]
+    services:
+      - name: api
+        url: https://staging.api.example.com
+  prod:
+    timeout: 2s
+`
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	defer SetProfile("")
+
+	SetProfile("staging")
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+	if cfg.CheckInterval != "10s" || cfg.RetryAttempts != 1 || cfg.Timeout != "5s" {
+		t.Errorf("Expected staging overrides on base settings, got interval %s, retries %d, timeout %s", cfg.CheckInterval, cfg.RetryAttempts, cfg.Timeout)
+	}
+	var names []string
+	for _, s := range cfg.Services {
+		names = append(names, s.Name)
+	}
+	if strings.Join(names, ",") != "website,api" {
+		t.Errorf("Expected included group plus profile services, got %v", names)
+	}
+	if err := SaveConfig(cfg); err == nil {
+		t.Error("Expected saving with an active profile to fail")
+	}
+
+	SetProfile("prod")
+	cfg, err = LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+	if cfg.Timeout != "2s" || len(cfg.Services) != 2 {
+		t.Errorf("Expected prod to keep every base service with its own timeout, got %s and %d services", cfg.Timeout, len(cfg.Services))
+	}
+
+	SetProfile("qa")
+	if _, err := LoadConfig(); err == nil || !strings.Contains(err.Error(), "available: prod, staging") {
+		t.Errorf("Expected unknown profile error listing profiles, got %v", err)
+	}
+}
+
+func TestLoadConfigEnvOverrides(t *testing.T) {
+	tmpHome := t.TempDir()
+	t.Setenv("HOME", tmpHome)
+
+	configPath := filepath.Join(tmpHome, ".config", "scout", "config.yml")
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	content := `check_interval: 30s
+timeout: 5s
+retry_attempts: 3
+services:
+  - name: api
+    url: https://api.example.com
+`
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("SCOUT_CHECK_INTERVAL", "10s")
+	t.Setenv("SCOUT_RETRY_ATTEMPTS", "1")
+	t.Setenv("SCOUT_LENIENT_STATUS", "true")
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+	if cfg.CheckInterval != "10s" || cfg.RetryAttempts != 1 || !cfg.LenientStatus || cfg.Timeout != "5s" {
+		t.Errorf("Expected env overrides on file settings, got interval %s, retries %d, lenient %v, timeout %s", cfg.CheckInterval, cfg.RetryAttempts, cfg.LenientStatus, cfg.Timeout)
+	}
+
+	// Saving keeps the file's values rather than the overrides
+	cfg.Services[0].URL = "https://api2.example.com"
+	if err := SaveConfig(cfg); err != nil {
+		t.Fatalf("SaveConfig failed: %v", err)
+	}
+	saved, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(saved), "check_interval: 30s") || strings.Contains(string(saved), "lenient_status") || !strings.Contains(string(saved), "api2.example.com") {
+		t.Errorf("Expected the saved file to keep its own settings, got:\n%s", saved)
+	}
+
+	t.Setenv("SCOUT_RETRY_ATTEMPTS", "many")
+	if _, err := LoadConfig(); err == nil || !strings.Contains(err.Error(), "SCOUT_RETRY_ATTEMPTS") {
+		t.Errorf("Expected an invalid override to name the variable, got %v", err)
+	}
+
+	// Overrides alone are enough without a config file
+	t.Setenv("SCOUT_RETRY_ATTEMPTS", "2")
+	if err := os.Remove(configPath); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err = LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig without a file failed: %v", err)
+	}
+	if cfg.CheckInterval != "10s" || cfg.Timeout != DefaultTimeout || cfg.RetryAttempts != 2 {
+		t.Errorf("Expected overrides on the defaults, got interval %s, timeout %s, retries %d", cfg.CheckInterval, cfg.Timeout, cfg.RetryAttempts)
+	}
+}
+
+func TestLoadConfigDuplicateNames(t *testing.T) {
+	tmpHome := t.TempDir()
+	t.Setenv("HOME", tmpHome)
+
+	configPath := filepath.Join(tmpHome, ".config", "scout", "config.yml")
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	data := `check_interval: 30s
+timeout: 5s
+services:
+  - name: api
+    url: http://one.example.com
+  - name: web
+    url: http://web.example.com
+  - name: api
+    url: http://two.example.com
+`
+	if err := os.WriteFile(configPath, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := LoadConfig()
+	if err == nil {
+		t.Fatal("Expected duplicate service names to fail")
+	}
+	if !strings.Contains(err.Error(), "'api' (config.yml, config.yml)") || strings.Contains(err.Error(), "'web'") {
+		t.Errorf("Expected error to list only the conflicting name, got %v", err)
+	}
+}
+
+func TestSaveConfigPreservesComments(t *testing.T) {
+	tmpHome := t.TempDir()
+	t.Setenv("HOME", tmpHome)
+
+	configPath := filepath.Join(tmpHome, ".config", "scout", "config.yml")
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	data := `# Scout config for the platform team
+check_interval: 30s # keep in sync with alerting
+timeout: 5s
+retry_attempts: 3
+
+services:
+  # Public API, owned by the platform team
+  - name: api
+    url: https://api.example.com
+    health_endpoint: /health # cheap endpoint
+
+  # Legacy service, remove after migration
+  - name: legacy
+    url: https://legacy.example.com
+`
+	if err := os.WriteFile(configPath, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+	if err := cfg.RemoveService("legacy"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.AddService(Service{Name: "web", URL: "https://web.example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	cfg.Timeout = "10s"
+	if err := SaveConfig(cfg); err != nil {
+		t.Fatalf("SaveConfig failed: %v", err)
+	}
+
+	saved, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := string(saved)
+	for _, comment := range []string{
+		"# Scout config for the platform team",
+		"check_interval: 30s # keep in sync with alerting",
+		"# Public API, owned by the platform team",
+		"health_endpoint: /health # cheap endpoint",
+		"timeout: 10s",
+		"- name: web",
+	} {
+		if !strings.Contains(out, comment) {
+			t.Errorf("Expected saved config to contain %q, got:\n%s", comment, out)
+		}
+	}
+	if strings.Contains(out, "legacy") {
+		t.Errorf("Expected removed service and its comment to be gone, got:\n%s", out)
+	}
+
+	cfg, err = LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig after save failed: %v", err)
+	}
+	if len(cfg.Services) != 2 || cfg.Services[1].Name != "web" {
+		t.Errorf("Expected api and web after save, got %+v", cfg.Services)
+	}
+}
+
+func TestSetServiceEnabled(t *testing.T) {
+	cfg := &Config{
+		Services: []Service{{Name: "api", URL: "http://example.com"}},
+	}
+
+	if !cfg.Services[0].IsEnabled() {
+		t.Error("Expected service to be enabled by default")
+	}
+
+	if err := cfg.SetServiceEnabled("api", false); err != nil {
+		t.Fatalf("SetServiceEnabled failed: %v", err)
+	}
+	if cfg.Services[0].IsEnabled() {
+		t.Error("Expected service to be disabled")
+	}
+
+	if err := cfg.SetServiceEnabled("api", true); err != nil {
+		t.Fatalf("SetServiceEnabled failed: %v", err)
+	}
+	if !cfg.Services[0].IsEnabled() || cfg.Services[0].Enabled != nil {
+		t.Error("Expected service to be enabled with default flag")
+	}
+
+	if err := cfg.SetServiceEnabled("missing", false); err == nil {
+		t.Error("Expected error for unknown service")
+	}
+}
+
+func TestFindService(t *testing.T) {
+	cfg := &Config{
+		Services: []Service{
+			{Name: "api", URL: "http://api.example.com"},
+			{Name: "web", URL: "http://web.example.com"},
+			{Name: "db", URL: "tcp://db.example.com:5432"},
+		},
+	}
+
+	found := cfg.FindService("web")
+	if found == nil {
+		t.Fatal("Expected to find service 'web'")
+	}
+	if found.Name != "web" || found.URL != "http://web.example.com" {
+		t.Errorf("Expected service 'web', got %q (%s)", found.Name, found.URL)
+	}
+
+	// The result must point at the slice element, not a copy
+	if found != &cfg.Services[1] {
+		t.Error("Expected FindService to return the element in cfg.Services")
+	}
+
+	if cfg.FindService("missing") != nil {
+		t.Error("Expected nil for unknown service")
+	}
+}
+
+func TestInferType(t *testing.T) {
+	tests := []struct {
+		url      string
+		expected string
+	}{
+		{"https://api.example.com/health", "http"},
+		{"http://localhost:8080", "http"},
+		{"tcp://db.example.com:5432", "tcp"},
+		{"db.example.com:5432", "tcp"},
+		{"[::1]:6379", "tcp"},
+		{"grpc://orders.internal:50051", "http"},
+		{"ws://localhost:3000/socket", "http"},
+		{"TCP://db.example.com:5432", "tcp"},
+		{"api.example.com", "http"},
+		{"localhost:8080/health", "http"},
+		{"${API_URL}", "http"},
+	}
+
+	for _, tt := range tests {
+		if got := InferType(tt.url); got != tt.expected {
+			t.Errorf("InferType(%q) = %q, expected %q", tt.url, got, tt.expected)
+		}
+	}
+
+	// An explicit type always wins
+	service := Service{URL: "db.example.com:5432", Type: "tls"}
+	if got := service.CheckerType(); got != "tls" {
+		t.Errorf("Expected explicit type tls, got %q", got)
+	}
+}
+
+func TestResolveValue(t *testing.T) {
+	os.Setenv("TEST_TOKEN", "env-token")
+	defer os.Unsetenv("TEST_TOKEN")
+
+	secretPath := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(secretPath, []byte("file-token\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	val, err := ResolveValue("Bearer ${TEST_TOKEN}")
+	if err != nil || val != "Bearer env-token" {
+		t.Errorf("Expected 'Bearer env-token', got '%s' (%v)", val, err)
+	}
+
+	val, err = ResolveValue("${file:" + secretPath + "}")
+	if err != nil || val != "file-token" {
+		t.Errorf("Expected 'file-token', got '%s' (%v)", val, err)
+	}
+
+	// Contents are cached until the TTL expires
+	if err := os.WriteFile(secretPath, []byte("rotated"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	val, _ = ResolveValue("${file:" + secretPath + "}")
+	if val != "file-token" {
+		t.Errorf("Expected cached 'file-token', got '%s'", val)
+	}
+
+	// Values without placeholders are returned untouched, including bare $VAR
+	val, _ = ResolveValue("pa$$word}")
+	if val != "pa$$word}" {
+		t.Errorf("Expected value untouched, got '%s'", val)
+	}
+	val, _ = ResolveValue("Bearer $TEST_TOKEN")
+	if val != "Bearer $TEST_TOKEN" {
+		t.Errorf("Expected bare $VAR left as written, got '%s'", val)
+	}
+
+	if _, err := ResolveValue("${file:/nonexistent/secret}"); err == nil {
+		t.Error("Expected error for missing secret file")
+	}
+}
+
+func TestServiceYAML(t *testing.T) {
+	data, err := ServiceYAML(Service{Name: "api", URL: "https://api.example.com", Source: "conf.d/api.yml"})
+	if err != nil {
+		t.Fatalf("ServiceYAML failed: %v", err)
+	}
+
+	expected := "services:\n  - name: api\n    url: https://api.example.com\n"
+	if string(data) != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, data)
 	}
 }
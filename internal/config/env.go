@@ -0,0 +1,99 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// envPrefix starts the environment variables that override global
+// settings, e.g. SCOUT_CHECK_INTERVAL for check_interval
+const envPrefix = "SCOUT_"
+
+// envName returns the environment variable overriding a setting's YAML key
+func envName(key string) string {
+	return envPrefix + strings.ToUpper(key)
+}
+
+// envSettings returns the config's top-level string, integer and boolean
+// fields by YAML key. Fields kept out of the file, like AllowExec, can't be
+// overridden either.
+func envSettings() map[string]int {
+	settings := make(map[string]int)
+	t := reflect.TypeOf(Config{})
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		key, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if !field.IsExported() || key == "" || key == "-" {
+			continue
+		}
+		switch field.Type.Kind() {
+		case reflect.String, reflect.Int, reflect.Bool:
+			settings[key] = i
+		}
+	}
+	return settings
+}
+
+// hasEnvOverrides reports whether any recognized SCOUT_* variable is set
+func hasEnvOverrides() bool {
+	for key := range envSettings() {
+		if _, ok := os.LookupEnv(envName(key)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// applyEnv overrides global settings from SCOUT_* environment variables,
+// which take precedence over the config file and profiles. The file's values
+// are kept so SaveConfig doesn't write the overrides back.
+func (c *Config) applyEnv() error {
+	v := reflect.ValueOf(c).Elem()
+	for key, index := range envSettings() {
+		name := envName(key)
+		raw, ok := os.LookupEnv(name)
+		if !ok {
+			continue
+		}
+
+		field := v.Field(index)
+		original := field.Interface()
+		switch field.Kind() {
+		case reflect.String:
+			field.SetString(raw)
+		case reflect.Int:
+			n, err := strconv.Atoi(strings.TrimSpace(raw))
+			if err != nil {
+				return fmt.Errorf("invalid %s: expected an integer, got %q", name, raw)
+			}
+			field.SetInt(int64(n))
+		case reflect.Bool:
+			b, err := strconv.ParseBool(strings.TrimSpace(raw))
+			if err != nil {
+				return fmt.Errorf("invalid %s: expected true or false, got %q", name, raw)
+			}
+			field.SetBool(b)
+		}
+
+		if c.envOriginals == nil {
+			c.envOriginals = make(map[int]interface{})
+		}
+		if _, seen := c.envOriginals[index]; !seen {
+			c.envOriginals[index] = original
+		}
+	}
+	return nil
+}
+
+// withoutEnv returns a copy of the config with the file's values in place of
+// any environment overrides
+func (c Config) withoutEnv() Config {
+	v := reflect.ValueOf(&c).Elem()
+	for index, original := range c.envOriginals {
+		v.Field(index).Set(reflect.ValueOf(original))
+	}
+	return c
+}
@@ -0,0 +1,82 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Profile overrides global settings and services for one environment,
+// selected at launch with --profile. Unset fields keep the base values.
+type Profile struct {
+	CheckInterval string         `yaml:"check_interval,omitempty"`
+	Timeout       string         `yaml:"timeout,omitempty"`
+	RetryAttempts *int           `yaml:"retry_attempts,omitempty"`
+	Jitter        string         `yaml:"jitter,omitempty"`
+	LenientStatus *bool          `yaml:"lenient_status,omitempty"`
+	CAFile        string         `yaml:"ca_file,omitempty"`
+	Notifications *Notifications `yaml:"notifications,omitempty"` // Replaces the base notifications block
+
+	// Base services are shared by every profile unless IncludeGroups limits
+	// them to those in the listed groups; Services are added to them
+	IncludeGroups []string  `yaml:"include_groups,omitempty"`
+	Services      []Service `yaml:"services,omitempty"`
+}
+
+// activeProfile is the profile LoadConfig resolves, if any
+var activeProfile string
+
+// SetProfile selects the profile LoadConfig resolves into the effective config
+func SetProfile(name string) {
+	activeProfile = name
+}
+
+// applyProfile resolves a profile's overrides and services into the config
+func (c *Config) applyProfile(name string) error {
+	profile, ok := c.Profiles[name]
+	if !ok {
+		available := make([]string, 0, len(c.Profiles))
+		for profileName := range c.Profiles {
+			available = append(available, profileName)
+		}
+		sort.Strings(available)
+		if len(available) == 0 {
+			return fmt.Errorf("profile '%s' not found (no profiles are configured)", name)
+		}
+		return fmt.Errorf("profile '%s' not found (available: %s)", name, strings.Join(available, ", "))
+	}
+
+	if profile.CheckInterval != "" {
+		c.CheckInterval = profile.CheckInterval
+	}
+	if profile.Timeout != "" {
+		c.Timeout = profile.Timeout
+	}
+	if profile.RetryAttempts != nil {
+		c.RetryAttempts = *profile.RetryAttempts
+	}
+	if profile.Jitter != "" {
+		c.Jitter = profile.Jitter
+	}
+	if profile.LenientStatus != nil {
+		c.LenientStatus = *profile.LenientStatus
+	}
+	if profile.CAFile != "" {
+		c.CAFile = profile.CAFile
+	}
+	if profile.Notifications != nil {
+		c.Notifications = *profile.Notifications
+	}
+
+	services := []Service{}
+	for _, s := range c.Services {
+		if len(profile.IncludeGroups) == 0 || slices.Contains(profile.IncludeGroups, s.Group) {
+			services = append(services, s)
+		}
+	}
+	c.Services = append(services, profile.Services...)
+	c.profile = name
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+
+	"gopkg.in/yaml.v3"
+)
+
+// marshalPreserving marshals v, reusing the nodes of the existing YAML
+// document wherever values are unchanged so comments and layout survive.
+// Services are matched by name, so editing one service leaves the rest of
+// the file untouched.
+func marshalPreserving(existing []byte, v interface{}) ([]byte, error) {
+	var updated yaml.Node
+	if err := updated.Encode(v); err != nil {
+		return nil, fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(existing, &doc); err != nil || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		// Nothing usable to preserve
+		return encodeYAML(&updated)
+	}
+
+	doc.Content[0] = mergeNodes(doc.Content[0], &updated)
+	return encodeYAML(&doc)
+}
+
+// encodeYAML encodes a node with two-space indentation
+func encodeYAML(node *yaml.Node) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(node); err != nil {
+		return nil, fmt.Errorf("failed to marshal config: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("failed to marshal config: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// mergeNodes returns a node with updated's content that keeps old's nodes,
+// and therefore their comments, wherever the content is unchanged
+func mergeNodes(old, updated *yaml.Node) *yaml.Node {
+	switch {
+	case nodesEqual(old, updated):
+		return old
+	case old.Kind == yaml.MappingNode && updated.Kind == yaml.MappingNode:
+		return mergeMappings(old, updated)
+	case old.Kind == yaml.SequenceNode && updated.Kind == yaml.SequenceNode:
+		return mergeSequences(old, updated)
+	default:
+		updated.HeadComment = old.HeadComment
+		updated.LineComment = old.LineComment
+		updated.FootComment = old.FootComment
+		return updated
+	}
+}
+
+// mergeMappings keeps old's key order and comments, dropping keys no longer
+// present and appending new ones
+func mergeMappings(old, updated *yaml.Node) *yaml.Node {
+	values := make(map[string]*yaml.Node, len(updated.Content)/2)
+	for i := 0; i+1 < len(updated.Content); i += 2 {
+		values[updated.Content[i].Value] = updated.Content[i+1]
+	}
+
+	merged := *old
+	merged.Content = nil
+	seen := make(map[string]bool, len(values))
+	for i := 0; i+1 < len(old.Content); i += 2 {
+		key := old.Content[i]
+		value, ok := values[key.Value]
+		if !ok {
+			continue
+		}
+		seen[key.Value] = true
+		merged.Content = append(merged.Content, key, mergeNodes(old.Content[i+1], value))
+	}
+
+	for i := 0; i+1 < len(updated.Content); i += 2 {
+		if !seen[updated.Content[i].Value] {
+			merged.Content = append(merged.Content, updated.Content[i], updated.Content[i+1])
+		}
+	}
+
+	return &merged
+}
+
+// mergeSequences matches mapping items by their name field, in updated's
+// order, so services keep their comments across adds and removes
+func mergeSequences(old, updated *yaml.Node) *yaml.Node {
+	byName := make(map[string]*yaml.Node)
+	for _, item := range old.Content {
+		if name := nodeName(item); name != "" {
+			byName[name] = item
+		}
+	}
+	if len(byName) == 0 {
+		return updated
+	}
+
+	merged := *old
+	merged.Content = nil
+	for _, item := range updated.Content {
+		if previous, ok := byName[nodeName(item)]; ok {
+			merged.Content = append(merged.Content, mergeNodes(previous, item))
+		} else {
+			merged.Content = append(merged.Content, item)
+		}
+	}
+
+	return &merged
+}
+
+// nodeName returns the value of a mapping node's name field
+func nodeName(node *yaml.Node) string {
+	if node.Kind != yaml.MappingNode {
+		return ""
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == "name" {
+			return node.Content[i+1].Value
+		}
+	}
+	return ""
+}
+
+// nodesEqual reports whether two nodes decode to the same value
+func nodesEqual(a, b *yaml.Node) bool {
+	var av, bv interface{}
+	if err := a.Decode(&av); err != nil {
+		return false
+	}
+	if err := b.Decode(&bv); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(av, bv)
+}
@@ -0,0 +1,50 @@
+package monitor
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+)
+
+// loadCAFile reads a PEM bundle of root CAs, trusting them in addition to
+// the system roots
+func loadCAFile(path string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA file: %w", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("CA file %s contains no valid PEM certificates", path)
+	}
+	return pool, nil
+}
+
+// caTransport returns an HTTP transport that verifies servers against pool
+func caTransport(pool *x509.CertPool) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	return transport
+}
+
+// applyRootCAs makes every checker that verifies certificates trust pool
+func applyRootCAs(checkers map[string]Checker, pool *x509.CertPool) {
+	for _, checker := range checkers {
+		switch c := checker.(type) {
+		case *HTTPChecker:
+			c.client.Transport = caTransport(pool)
+		case *LatencyChecker:
+			c.client.Transport = caTransport(pool)
+		case *DownloadChecker:
+			c.client.Transport = caTransport(pool)
+		case *TLSChecker:
+			c.rootCAs = pool
+		}
+	}
+}
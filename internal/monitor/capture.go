@@ -0,0 +1,40 @@
+package monitor
+
+import (
+	"net/http"
+	"time"
+)
+
+// MaxCaptureBytes limits how much of a response body is retained per service
+const MaxCaptureBytes = 64 * 1024
+
+// Capture holds the request and response details of a service's most recent
+// check, retained for services with capture enabled
+type Capture struct {
+	Method     string
+	URL        string
+	StatusCode int
+	Headers    http.Header
+	Body       string
+	Truncated  bool
+	CapturedAt time.Time
+}
+
+// newCapture records a response, truncating the body to MaxCaptureBytes
+func newCapture(req *http.Request, resp *http.Response, body []byte) *Capture {
+	capture := &Capture{
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		StatusCode: resp.StatusCode,
+		Headers:    resp.Header.Clone(),
+		CapturedAt: time.Now(),
+	}
+
+	if len(body) > MaxCaptureBytes {
+		body = body[:MaxCaptureBytes]
+		capture.Truncated = true
+	}
+	capture.Body = string(body)
+
+	return capture
+}
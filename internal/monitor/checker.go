@@ -1,13 +1,24 @@
 package monitor
 
 import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"io"
+	"mime"
 	"net"
 	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/juststeveking/scout/internal/config"
@@ -21,7 +32,12 @@ type Checker interface {
 
 // HTTPChecker performs HTTP-based health checks
 type HTTPChecker struct {
-	client *http.Client
+	client      *http.Client
+	dialTimeout time.Duration // Connection timeout within the client's overall timeout, when set
+	lenient     bool          // Treat any status below 400 as healthy
+
+	muSchemaLock sync.Mutex
+	schemas      map[string]*jsonSchema // Compiled json_schema values, keyed by the config value
 }
 
 // NewHTTPChecker creates a new HTTP checker
@@ -33,9 +49,18 @@ func NewHTTPChecker(timeout time.Duration) *HTTPChecker {
 				return http.ErrUseLastResponse // Don't follow redirects
 			},
 		},
+		schemas: make(map[string]*jsonSchema),
 	}
 }
 
+// NewReachableChecker creates an HTTP checker that considers any status
+// below 400 healthy, ignoring expected_status
+func NewReachableChecker(timeout time.Duration) *HTTPChecker {
+	h := NewHTTPChecker(timeout)
+	h.lenient = true
+	return h
+}
+
 // Close closes the HTTP client's connection pool
 func (h *HTTPChecker) Close() {
 	if h.client != nil && h.client.Transport != nil {
@@ -51,54 +76,33 @@ func (h *HTTPChecker) Check(ctx context.Context, service config.Service) Result
 		CheckedAt:   time.Now(),
 	}
 
-	// Build the full URL
-	url := service.URL
-	if service.HealthEndpoint != "" {
-		url = strings.TrimRight(url, "/") + service.HealthEndpoint
-	}
-
-	// Default to GET if no method specified
-	method := service.Method
-	if method == "" {
-		method = "GET"
-	}
-
-	// Create request
-	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	// Create request with headers and auth
+	req, err := buildRequest(ctx, service)
 	if err != nil {
 		result.Status = StatusUnhealthy
-		result.Error = fmt.Errorf("failed to create request: %w", err)
+		result.Error = err
 		return result
 	}
 
-	// Add custom headers
-	for key, value := range service.Headers {
-		req.Header.Set(key, value)
-	}
-
-	// Add authentication headers
-	if service.Auth != nil {
-		switch strings.ToLower(service.Auth.Type) {
-		case "bearer":
-			if service.Auth.Token != "" {
-				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", service.Auth.Token))
-			}
-		case "basic":
-			if service.Auth.Username != "" && service.Auth.Password != "" {
-				req.SetBasicAuth(service.Auth.Username, service.Auth.Password)
-			}
-		}
+	client, err := clientFor(h.client, h.dialTimeout, service)
+	if err != nil {
+		result.Status = StatusUnhealthy
+		result.Error = err
+		return result
 	}
 
-	// Perform the request
+	// Perform the request, tracing its connection phases
+	req, trace := traceRequest(req)
 	start := time.Now()
-	resp, err := h.client.Do(req)
+	resp, err := client.Do(req)
 	result.ResponseTime = time.Since(start)
+	result.Details = trace.Timing()
 
 	if err != nil {
 		result.Status = StatusUnhealthy
 		result.Error = err
 		result.Message = "Connection failed"
+		result.FailureReason = classifyError(err, ReasonConnect)
 		return result
 	}
 	defer resp.Body.Close()
@@ -106,30 +110,114 @@ func (h *HTTPChecker) Check(ctx context.Context, service config.Service) Result
 	result.StatusCode = resp.StatusCode
 
 	// Read response body
-	body, err := io.ReadAll(resp.Body)
+	body, err := readBody(resp)
 	if err != nil {
 		result.Status = StatusUnhealthy
 		result.Error = fmt.Errorf("failed to read response body: %w", err)
+		result.FailureReason = classifyError(err, ReasonConnect)
 		return result
 	}
 
+	if service.Capture {
+		result.Capture = newCapture(req, resp, body)
+	}
+	result.Fields = extractFields(body, service.DisplayFields)
+
 	// Check if status code matches expected
 	expectedStatus := service.ExpectedStatus
 	if expectedStatus == 0 {
 		expectedStatus = 200
 	}
 
-	if resp.StatusCode != expectedStatus {
+	statusOK := resp.StatusCode == expectedStatus
+	expected := strconv.Itoa(expectedStatus)
+	if h.lenient {
+		statusOK = resp.StatusCode < http.StatusBadRequest
+		expected = "< 400"
+	}
+
+	if !statusOK {
 		result.Status = StatusUnhealthy
-		result.Message = fmt.Sprintf("Expected %d, got %d", expectedStatus, resp.StatusCode)
+		result.Message = fmt.Sprintf("Expected %s, got %d", expected, resp.StatusCode)
+		result.FailureReason = ReasonStatus
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+			result.RetryAfter = parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+		}
 		return result
 	}
 
+	// Verify where a redirect points, not just that it happened
+	if service.ExpectedLocation != "" && resp.StatusCode >= 300 && resp.StatusCode < 400 {
+		location := resp.Header.Get("Location")
+		matched, err := matchesLocation(location, service.ExpectedLocation)
+		if err != nil {
+			result.Status = StatusUnhealthy
+			result.Error = err
+			return result
+		}
+		if !matched {
+			result.Status = StatusUnhealthy
+			result.Message = fmt.Sprintf("Expected location %s, got %q", service.ExpectedLocation, location)
+			result.FailureReason = ReasonAssertion
+			return result
+		}
+	}
+
+	// Catch error pages served with the expected status
+	if service.ExpectedContentType != "" {
+		if !matchesContentType(resp.Header.Get("Content-Type"), service.ExpectedContentType) {
+			result.Status = StatusUnhealthy
+			result.Message = fmt.Sprintf("Expected content type %s, got %q", service.ExpectedContentType, resp.Header.Get("Content-Type"))
+			result.FailureReason = ReasonAssertion
+			return result
+		}
+	}
+
+	// Check response headers, e.g. remaining rate limit
+	if len(service.HeaderAssertions) > 0 {
+		if err := h.validateHeaderAssertions(resp.Header, service.HeaderAssertions); err != nil {
+			result.Status = StatusUnhealthy
+			result.Error = err
+			result.FailureReason = ReasonAssertion
+			return result
+		}
+	}
+
+	// Validate the whole payload against a JSON schema
+	if service.JSONSchema != "" {
+		schema, err := h.schemaFor(service.JSONSchema)
+		if err != nil {
+			result.Status = StatusUnhealthy
+			result.Error = err
+			return result
+		}
+		if err := schema.Validate(body); err != nil {
+			result.Status = StatusUnhealthy
+			result.Error = fmt.Errorf("JSON schema validation failed: %w", err)
+			result.FailureReason = ReasonAssertion
+			return result
+		}
+	}
+
+	// Compare the whole payload to a known-good response
+	if service.GoldenFile != "" {
+		if err := compareGolden(body, service.GoldenFile, service.IgnorePaths); err != nil {
+			result.Status = StatusUnhealthy
+			result.Error = err
+			result.FailureReason = ReasonAssertion
+			return result
+		}
+	}
+
 	// If there are JSON assertions, validate them
 	if len(service.JSONAssertions) > 0 {
-		if err := h.validateJSONAssertions(string(body), service.JSONAssertions, result); err != nil {
+		var err error
+		result.Assertions, err = h.validateJSONAssertions(string(body), service.JSONAssertions)
+		if err != nil {
 			result.Status = StatusUnhealthy
 			result.Error = err
+			result.Message = AssertionSummary(result.Assertions)
+			result.FailureReason = ReasonAssertion
 			return result
 		}
 	}
@@ -140,29 +228,408 @@ func (h *HTTPChecker) Check(ctx context.Context, service config.Service) Result
 	return result
 }
 
-// validateJSONAssertions checks JSON assertions against the response body
-func (h *HTTPChecker) validateJSONAssertions(body string, assertions []config.JSONAssertion, _ Result) error {
-	for _, assertion := range assertions {
-		value := gjson.Get(body, assertion.Path)
+// extractFields looks up each display field's JSON path in a response body,
+// skipping paths the body doesn't contain
+func extractFields(body []byte, paths []string) []Field {
+	if len(paths) == 0 || !gjson.ValidBytes(body) {
+		return nil
+	}
 
+	var fields []Field
+	for _, path := range paths {
+		value := gjson.GetBytes(body, path)
 		if !value.Exists() {
-			return fmt.Errorf("JSON path '%s' not found in response", assertion.Path)
+			continue
+		}
+		fields = append(fields, Field{Path: path, Value: value.String()})
+	}
+	return fields
+}
+
+// matchesContentType reports whether a Content-Type header has the expected
+// media type, ignoring case and parameters such as charset. An expected
+// type ending in /*, like text/*, matches any subtype.
+func matchesContentType(header, expected string) bool {
+	mediaType, _, err := mime.ParseMediaType(header)
+	if err != nil {
+		return false
+	}
+	expected = strings.ToLower(strings.TrimSpace(expected))
+	if prefix, ok := strings.CutSuffix(expected, "*"); ok && strings.HasSuffix(prefix, "/") {
+		return strings.HasPrefix(mediaType, prefix)
+	}
+	return mediaType == expected
+}
+
+// matchesLocation reports whether a Location header matches the expected
+// target: a regular expression when prefixed with ~, a prefix when ending
+// in *, and otherwise the exact value
+func matchesLocation(location, expected string) (bool, error) {
+	if pattern, ok := strings.CutPrefix(expected, "~"); ok {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return false, fmt.Errorf("invalid expected_location pattern: %w", err)
+		}
+		return re.MatchString(location), nil
+	}
+	if prefix, ok := strings.CutSuffix(expected, "*"); ok {
+		return strings.HasPrefix(location, prefix), nil
+	}
+	return location == expected, nil
+}
+
+// readBody reads a response body, decompressing gzip and deflate encodings
+// that net/http leaves compressed (e.g. when Accept-Encoding is set explicitly)
+func readBody(resp *http.Response) ([]byte, error) {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil || resp.Uncompressed || len(body) == 0 {
+		return body, err
+	}
+
+	var reader io.ReadCloser
+	switch strings.ToLower(strings.TrimSpace(resp.Header.Get("Content-Encoding"))) {
+	case "gzip", "x-gzip":
+		reader, err = gzip.NewReader(bytes.NewReader(body))
+	case "deflate":
+		// "deflate" is zlib-wrapped per the spec, but some servers send raw deflate
+		reader, err = zlib.NewReader(bytes.NewReader(body))
+		if err != nil {
+			reader, err = flate.NewReader(bytes.NewReader(body)), nil
+		}
+	default:
+		return body, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress body: %w", err)
+	}
+	defer reader.Close()
+
+	decoded, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress body: %w", err)
+	}
+	return decoded, nil
+}
+
+// parseRetryAfter parses a Retry-After header given as delay-seconds or an
+// HTTP date, returning zero if it is missing or invalid
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if delay := date.Sub(now); delay > 0 {
+			return delay
+		}
+	}
+
+	return 0
+}
+
+// jsonExistsSentinel is the expected value that turns "!=" into an absence check
+const jsonExistsSentinel = "exists"
+
+// jsonReferencePrefix marks an expected value as another path in the same
+// response, e.g. "$.total", for relational checks like processed == total
+const jsonReferencePrefix = "$."
+
+// buildRequest creates the HTTP request for a service's check, including
+// custom headers and authentication
+func buildRequest(ctx context.Context, service config.Service) (*http.Request, error) {
+	url, err := resolveRequestURL(service)
+	if err != nil {
+		return nil, err
+	}
+
+	// Default to GET if no method specified
+	method := service.Method
+	if method == "" {
+		method = "GET"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Convert credentials embedded in the URL into Basic auth; explicitly
+	// configured auth takes precedence
+	if req.URL.User != nil {
+		if service.Auth == nil || service.Auth.Type == "" {
+			password, _ := req.URL.User.Password()
+			req.SetBasicAuth(req.URL.User.Username(), password)
+		}
+		req.URL.User = nil
+	}
+
+	if err := setRequestHeaders(req, service); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// RequestURL returns the final URL a service's HTTP check requests, with
+// ${ENV} placeholders resolved and the health endpoint appended. Any
+// user:pass@ credentials are redacted, since the URL is for display.
+func RequestURL(service config.Service) (string, error) {
+	raw, err := resolveRequestURL(service)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := url.Parse(raw); err != nil {
+		return "", fmt.Errorf("invalid URL: %w", err)
+	}
+	return RedactURL(raw), nil
+}
+
+// RedactURL masks the password of any user:pass@ credentials in a URL for
+// display, leaving values that don't parse as URLs unchanged
+func RedactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.User == nil {
+		return raw
+	}
+	return u.Redacted()
+}
+
+// resolveRequestURL returns the URL a service's HTTP check requests,
+// including any credentials, for building the request itself
+func resolveRequestURL(service config.Service) (string, error) {
+	url, err := config.ResolveValue(service.URL)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve URL: %w", err)
+	}
+
+	if service.HealthEndpoint != "" {
+		endpoint, err := config.ResolveValue(service.HealthEndpoint)
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve health endpoint: %w", err)
+		}
+		url = strings.TrimRight(url, "/") + endpoint
+	}
+
+	return url, nil
+}
+
+// setRequestHeaders adds custom headers and authentication to a request,
+// resolving ${ENV} and ${file:/path} placeholders in their values
+func setRequestHeaders(req *http.Request, service config.Service) error {
+	for key, value := range service.Headers {
+		resolved, err := config.ResolveValue(value)
+		if err != nil {
+			return fmt.Errorf("failed to resolve header %s: %w", key, err)
 		}
 
-		if !h.compareValue(value, assertion.Value, assertion.Operator) {
-			return fmt.Errorf("JSON assertion failed: %s %s %v, got %v", assertion.Path, assertion.Operator, assertion.Value, value.Value())
+		// net/http ignores Host in the header map, so set it on the request
+		// to allow checking a virtual host behind a shared address
+		if strings.EqualFold(key, "Host") {
+			req.Host = resolved
+			continue
+		}
+		req.Header.Set(key, resolved)
+	}
+
+	if service.Auth == nil {
+		return nil
+	}
+
+	switch strings.ToLower(service.Auth.Type) {
+	case "bearer":
+		token, err := config.ResolveValue(service.Auth.Token)
+		if err != nil {
+			return fmt.Errorf("failed to resolve bearer token: %w", err)
+		}
+		if token != "" {
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		}
+	case "basic":
+		username, err := config.ResolveValue(service.Auth.Username)
+		if err != nil {
+			return fmt.Errorf("failed to resolve username: %w", err)
+		}
+		password, err := config.ResolveValue(service.Auth.Password)
+		if err != nil {
+			return fmt.Errorf("failed to resolve password: %w", err)
+		}
+		if username != "" && password != "" {
+			req.SetBasicAuth(username, password)
 		}
 	}
+
 	return nil
 }
 
+// schemaFor returns the compiled schema for a json_schema value, compiling
+// it on first use. Failures aren't cached so a fixed schema file is picked up.
+func (h *HTTPChecker) schemaFor(spec string) (*jsonSchema, error) {
+	h.muSchemaLock.Lock()
+	defer h.muSchemaLock.Unlock()
+
+	if schema, ok := h.schemas[spec]; ok {
+		return schema, nil
+	}
+
+	data, location, err := loadSchema(spec)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := compileSchema(data, location)
+	if err != nil {
+		return nil, err
+	}
+
+	h.schemas[spec] = schema
+	return schema, nil
+}
+
+// validateJSONAssertions checks every JSON assertion against the response
+// body, returning each outcome and an error describing the first failure
+func (h *HTTPChecker) validateJSONAssertions(body string, assertions []config.JSONAssertion) ([]AssertionResult, error) {
+	results := make([]AssertionResult, len(assertions))
+	var firstErr error
+	failed := 0
+
+	for i, assertion := range assertions {
+		results[i] = AssertionResult{Assertion: assertion, Passed: true}
+
+		var err error
+		value := gjson.Get(body, assertion.Path)
+		expected, describe, refErr := resolveExpected(body, assertion.Value)
+		if refErr != nil {
+			err = refErr
+		} else if !value.Exists() && !h.allowsMissingPath(assertion) {
+			err = fmt.Errorf("JSON path '%s' not found in response", assertion.Path)
+		} else if !h.compareValue(value, expected, assertion.Operator) {
+			err = fmt.Errorf("JSON assertion failed: %s %s %s, got %v", assertion.Path, assertion.Operator, describe, value.Value())
+		}
+
+		if err != nil {
+			results[i].Passed = false
+			results[i].Error = err
+			failed++
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	if failed > 1 {
+		return results, fmt.Errorf("%d of %d JSON assertions failed, first: %w", failed, len(assertions), firstErr)
+	}
+	return results, firstErr
+}
+
+// validateHeaderAssertions checks each header assertion against the response
+// headers, comparing values with the JSON assertion operators, and returns an
+// error describing the first failure
+func (h *HTTPChecker) validateHeaderAssertions(header http.Header, assertions []config.HeaderAssertion) error {
+	for _, assertion := range assertions {
+		// Present headers compare as JSON strings, which the numeric
+		// operators parse as numbers
+		var actual gjson.Result
+		values := header.Values(assertion.Header)
+		if len(values) > 0 {
+			actual = gjson.Result{Type: gjson.String, Str: strings.Join(values, ", ")}
+		}
+
+		asJSON := config.JSONAssertion{Path: assertion.Header, Value: assertion.Value, Operator: assertion.Operator}
+		if !actual.Exists() && !h.allowsMissingPath(asJSON) {
+			return fmt.Errorf("header %s not found in response", assertion.Header)
+		}
+
+		switch assertion.Operator {
+		case ">", "<", ">=", "<=":
+			got, err := strconv.ParseFloat(strings.TrimSpace(actual.Str), 64)
+			if err != nil {
+				return fmt.Errorf("header assertion failed: %s %s %v, got non-numeric %q", assertion.Header, assertion.Operator, assertion.Value, actual.Str)
+			}
+			if !h.compareValue(actual, assertion.Value, assertion.Operator) {
+				return fmt.Errorf("header assertion failed: %s %s %v, got %v", assertion.Header, assertion.Operator, assertion.Value, got)
+			}
+		default:
+			if !h.compareValue(actual, assertion.Value, assertion.Operator) {
+				return fmt.Errorf("header assertion failed: %s %s %v, got %q", assertion.Header, assertion.Operator, assertion.Value, actual.Str)
+			}
+		}
+	}
+	return nil
+}
+
+// resolveExpected returns an assertion's expected value and how to describe
+// it in failures. A "$."-prefixed string is looked up as a path in the body,
+// with arrays standing in for their length as on the asserted side.
+func resolveExpected(body string, expected interface{}) (interface{}, string, error) {
+	path, ok := expected.(string)
+	if !ok || !strings.HasPrefix(path, jsonReferencePrefix) {
+		return expected, fmt.Sprint(expected), nil
+	}
+
+	ref := gjson.Get(body, strings.TrimPrefix(path, jsonReferencePrefix))
+	if !ref.Exists() {
+		return nil, "", fmt.Errorf("JSON path '%s' referenced by the expected value not found in response", path)
+	}
+
+	var value interface{}
+	switch {
+	case ref.IsArray():
+		value = float64(len(ref.Array()))
+	case ref.Type == gjson.JSON:
+		return nil, "", fmt.Errorf("JSON path '%s' referenced by the expected value is an object", path)
+	default:
+		value = ref.Value()
+	}
+	return value, fmt.Sprintf("%s (%v)", path, value), nil
+}
+
+// AssertionSummary describes how many assertions passed, e.g.
+// "3/4 assertions passed"
+func AssertionSummary(assertions []AssertionResult) string {
+	passed := 0
+	for _, a := range assertions {
+		if a.Passed {
+			passed++
+		}
+	}
+	return fmt.Sprintf("%d/%d assertions passed", passed, len(assertions))
+}
+
+// allowsMissingPath reports whether an assertion is satisfied by a missing path
+func (h *HTTPChecker) allowsMissingPath(assertion config.JSONAssertion) bool {
+	switch strings.ToLower(assertion.Operator) {
+	case "absent", "empty":
+		return true
+	case "!=", "not_equals":
+		return assertion.Value == jsonExistsSentinel
+	default:
+		return false
+	}
+}
+
 // compareValue compares a gjson.Result with an expected value using the specified operator
 func (h *HTTPChecker) compareValue(actual gjson.Result, expected interface{}, operator string) bool {
 	switch strings.ToLower(operator) {
 	case "==", "equals":
 		return h.jsonValueEquals(actual, expected)
 	case "!=", "not_equals":
+		if expected == jsonExistsSentinel {
+			return !actual.Exists()
+		}
 		return !h.jsonValueEquals(actual, expected)
+	case "absent":
+		return !actual.Exists()
+	case "empty":
+		return h.jsonIsEmpty(actual)
 	case ">":
 		return h.jsonGreaterThan(actual, expected)
 	case "<":
@@ -183,8 +650,9 @@ func (h *HTTPChecker) jsonValueEquals(actual gjson.Result, expected interface{})
 	switch v := expected.(type) {
 	case string:
 		return actual.String() == v
-	case float64:
-		return actual.Float() == v
+	case float64, int, int64, uint64:
+		n, _ := expectedNumber(v)
+		return h.jsonNumber(actual) == n
 	case bool:
 		return actual.Bool() == v
 	case nil:
@@ -194,40 +662,109 @@ func (h *HTTPChecker) jsonValueEquals(actual gjson.Result, expected interface{})
 	}
 }
 
+// jsonIsEmpty checks if actual is missing, null, or an empty array, object, or string
+func (h *HTTPChecker) jsonIsEmpty(actual gjson.Result) bool {
+	if !actual.Exists() {
+		return true
+	}
+	switch actual.Type {
+	case gjson.Null:
+		return true
+	case gjson.String:
+		return actual.Str == ""
+	case gjson.JSON:
+		if actual.IsArray() {
+			return len(actual.Array()) == 0
+		}
+		return len(actual.Map()) == 0
+	default:
+		return false
+	}
+}
+
+// jsonNumber returns the numeric value of actual, using the element count for arrays
+// so that projections like "friends.#.name" or "@values" compare by length
+func (h *HTTPChecker) jsonNumber(actual gjson.Result) float64 {
+	if actual.IsArray() {
+		return float64(len(actual.Array()))
+	}
+	return actual.Float()
+}
+
 // jsonGreaterThan checks if actual > expected
 func (h *HTTPChecker) jsonGreaterThan(actual gjson.Result, expected interface{}) bool {
-	if v, ok := expected.(float64); ok {
-		return actual.Float() > v
-	}
-	return false
+	return h.jsonCompareNumbers(actual, expected, func(a, b float64) bool { return a > b })
 }
 
 // jsonLessThan checks if actual < expected
 func (h *HTTPChecker) jsonLessThan(actual gjson.Result, expected interface{}) bool {
-	if v, ok := expected.(float64); ok {
-		return actual.Float() < v
-	}
-	return false
+	return h.jsonCompareNumbers(actual, expected, func(a, b float64) bool { return a < b })
 }
 
 // jsonGreaterOrEqual checks if actual >= expected
 func (h *HTTPChecker) jsonGreaterOrEqual(actual gjson.Result, expected interface{}) bool {
-	if v, ok := expected.(float64); ok {
-		return actual.Float() >= v
-	}
-	return false
+	return h.jsonCompareNumbers(actual, expected, func(a, b float64) bool { return a >= b })
 }
 
 // jsonLessOrEqual checks if actual <= expected
 func (h *HTTPChecker) jsonLessOrEqual(actual gjson.Result, expected interface{}) bool {
-	if v, ok := expected.(float64); ok {
-		return actual.Float() <= v
+	return h.jsonCompareNumbers(actual, expected, func(a, b float64) bool { return a <= b })
+}
+
+// jsonCompareNumbers coerces both sides to numbers, so "3600" compares like
+// 3600, and fails when either side isn't numeric
+func (h *HTTPChecker) jsonCompareNumbers(actual gjson.Result, expected interface{}, cmp func(a, b float64) bool) bool {
+	want, ok := expectedNumber(expected)
+	if !ok {
+		return false
 	}
-	return false
+
+	if actual.Type == gjson.String {
+		got, err := strconv.ParseFloat(strings.TrimSpace(actual.Str), 64)
+		return err == nil && cmp(got, want)
+	}
+	return cmp(h.jsonNumber(actual), want)
 }
 
-// jsonContains checks if actual string contains expected substring
+// expectedNumber coerces an assertion's expected value to a number, accepting
+// YAML integers and numeric strings as well as floats
+func expectedNumber(expected interface{}) (float64, bool) {
+	switch v := expected.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case string:
+		n, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return n, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// jsonContains checks if actual string contains expected substring, or if
+// an actual array has an element containing it (or equal to a non-string)
 func (h *HTTPChecker) jsonContains(actual gjson.Result, expected interface{}) bool {
+	if actual.IsArray() {
+		// Strings match elements containing them, as they did against the
+		// raw array; other values must equal an element
+		for _, item := range actual.Array() {
+			if v, ok := expected.(string); ok {
+				if strings.Contains(item.String(), v) {
+					return true
+				}
+				continue
+			}
+			if h.jsonValueEquals(item, expected) {
+				return true
+			}
+		}
+		return false
+	}
 	if v, ok := expected.(string); ok {
 		return strings.Contains(actual.String(), v)
 	}
@@ -254,15 +791,30 @@ func (t *TCPChecker) Check(ctx context.Context, service config.Service) Result {
 		CheckedAt:   time.Now(),
 	}
 
+	dialer, err := dialerFor(service, t.timeout)
+	if err != nil {
+		result.Status = StatusUnhealthy
+		result.Error = err
+		return result
+	}
+
 	start := time.Now()
 
-	conn, err := net.DialTimeout("tcp", service.URL, t.timeout)
+	host, port := SplitServiceAddress(service.URL)
+	if port == "" {
+		result.Status = StatusUnhealthy
+		result.Error = fmt.Errorf("missing port in address %q", service.URL)
+		return result
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(host, port))
 	result.ResponseTime = time.Since(start)
 
 	if err != nil {
 		result.Status = StatusUnhealthy
 		result.Error = err
 		result.Message = "Connection refused"
+		result.FailureReason = classifyError(err, ReasonConnect)
 		return result
 	}
 
@@ -276,6 +828,7 @@ func (t *TCPChecker) Check(ctx context.Context, service config.Service) Result {
 // TLSChecker checks TLS certificate expiry
 type TLSChecker struct {
 	timeout time.Duration
+	rootCAs *x509.CertPool // Trusted roots from ca_file (nil for the system roots)
 }
 
 // NewTLSChecker creates a new TLS checker
@@ -293,34 +846,33 @@ func (t *TLSChecker) Check(ctx context.Context, service config.Service) Result {
 		CheckedAt:   time.Now(),
 	}
 
-	// Extract host from URL
-	host := service.URL
-	if strings.Contains(host, "://") {
-		host = strings.Split(host, "://")[1]
-	}
-	if strings.Contains(host, "/") {
-		host = strings.Split(host, "/")[0]
+	// Extract host from URL, adding the default HTTPS port if not specified
+	host, port := SplitServiceAddress(service.URL)
+	if port == "" {
+		port = "443"
 	}
+	address := net.JoinHostPort(host, port)
 
-	// Add default HTTPS port if not specified
-	if !strings.Contains(host, ":") {
-		host = host + ":443"
+	dialer, err := dialerFor(service, t.timeout)
+	if err != nil {
+		result.Status = StatusUnhealthy
+		result.Error = err
+		return result
 	}
 
-	// Use TLS dial with context
+	// Dial and handshake within the timeout
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+
 	start := time.Now()
-	tlsConn, err := tls.DialWithDialer(
-		&net.Dialer{Timeout: t.timeout},
-		"tcp",
-		host,
-		&tls.Config{InsecureSkipVerify: false},
-	)
+	tlsConn, err := dialTLS(ctx, dialer, address, t.rootCAs)
 	result.ResponseTime = time.Since(start)
 
 	if err != nil {
 		result.Status = StatusUnhealthy
 		result.Error = fmt.Errorf("TLS connection failed: %w", err)
 		result.Message = "TLS connection failed"
+		result.FailureReason = classifyError(err, ReasonTLS)
 		return result
 	}
 	defer tlsConn.Close()
@@ -331,10 +883,24 @@ func (t *TLSChecker) Check(ctx context.Context, service config.Service) Result {
 		result.Status = StatusUnhealthy
 		result.Error = fmt.Errorf("no certificates found")
 		result.Message = "No certificates found"
+		result.FailureReason = ReasonTLS
 		return result
 	}
 
 	cert := certs[0]
+
+	// A certificate other than the pinned one may mean interception or misissuance
+	if pinned := service.PinnedFingerprints(); len(pinned) > 0 {
+		fingerprint := certFingerprint(cert)
+		if !matchesFingerprint(fingerprint, pinned) {
+			result.Status = StatusUnhealthy
+			result.Error = fmt.Errorf("certificate fingerprint %s doesn't match pinned %s", fingerprint, strings.Join(pinned, ", "))
+			result.Message = "Certificate fingerprint mismatch"
+			result.FailureReason = ReasonTLS
+			return result
+		}
+	}
+
 	expiryDays := int(time.Until(cert.NotAfter).Hours() / 24)
 	warningDays := service.TLSWarningDays
 	if warningDays == 0 {
@@ -346,12 +912,14 @@ func (t *TLSChecker) Check(ctx context.Context, service config.Service) Result {
 	if time.Now().After(cert.NotAfter) {
 		result.Status = StatusUnhealthy
 		result.Error = fmt.Errorf("certificate expired on %s", cert.NotAfter.Format("2006-01-02"))
+		result.FailureReason = ReasonTLS
 		return result
 	}
 
 	if expiryDays < warningDays {
 		result.Status = StatusUnhealthy
 		result.Error = fmt.Errorf("certificate expires in %d days (warning threshold: %d days)", expiryDays, warningDays)
+		result.FailureReason = ReasonTLS
 		return result
 	}
 
@@ -359,6 +927,76 @@ func (t *TLSChecker) Check(ctx context.Context, service config.Service) Result {
 	return result
 }
 
+// certFingerprint returns a certificate's SHA-256 fingerprint as colon
+// separated hex, as shown by openssl x509 -fingerprint -sha256
+func certFingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	parts := make([]string, len(sum))
+	for i, b := range sum {
+		parts[i] = fmt.Sprintf("%02X", b)
+	}
+	return strings.Join(parts, ":")
+}
+
+// matchesFingerprint reports whether a fingerprint equals any pinned value,
+// ignoring case, colons, and a "sha256:" prefix
+func matchesFingerprint(fingerprint string, pinned []string) bool {
+	normalize := func(value string) string {
+		value = strings.ToLower(strings.TrimSpace(value))
+		value = strings.TrimPrefix(value, "sha256:")
+		return strings.ReplaceAll(value, ":", "")
+	}
+
+	for _, p := range pinned {
+		if normalize(p) == normalize(fingerprint) {
+			return true
+		}
+	}
+	return false
+}
+
+// SplitServiceAddress extracts the host and port from a service URL, which
+// may be a full URL, host:port, or a bare host, including bracketed and bare
+// IPv6 literals. The port is empty when none is given.
+func SplitServiceAddress(raw string) (host, port string) {
+	if strings.Contains(raw, "://") {
+		if u, err := url.Parse(raw); err == nil {
+			return u.Hostname(), u.Port()
+		}
+		_, raw, _ = strings.Cut(raw, "://")
+	}
+	raw, _, _ = strings.Cut(raw, "/")
+
+	if host, port, err := net.SplitHostPort(raw); err == nil {
+		return host, port
+	}
+
+	// No port: a hostname, or an IPv6 literal with or without brackets
+	return strings.TrimSuffix(strings.TrimPrefix(raw, "["), "]"), ""
+}
+
+// dialTLS connects to host through dialer and completes a TLS handshake,
+// verifying against rootCAs (or the system roots when nil)
+func dialTLS(ctx context.Context, dialer Dialer, host string, rootCAs *x509.CertPool) (*tls.Conn, error) {
+	conn, err := dialer.DialContext(ctx, "tcp", host)
+	if err != nil {
+		return nil, err
+	}
+
+	serverName, _, err := net.SplitHostPort(host)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	tlsConn := tls.Client(conn, &tls.Config{ServerName: serverName, RootCAs: rootCAs})
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return tlsConn, nil
+}
+
 // DNSChecker checks DNS resolution
 type DNSChecker struct {
 	timeout time.Duration
@@ -380,16 +1018,11 @@ func (d *DNSChecker) Check(ctx context.Context, service config.Service) Result {
 	}
 
 	// Extract host from URL
-	host := service.URL
-	if strings.Contains(host, "://") {
-		host = strings.Split(host, "://")[1]
-	}
-	if strings.Contains(host, "/") {
-		host = strings.Split(host, "/")[0]
-	}
-	if strings.Contains(host, ":") {
-		host = strings.Split(host, ":")[0]
-	}
+	host, _ := SplitServiceAddress(service.URL)
+
+	// Bound the lookup by the check timeout as well as cancellation
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
 
 	start := time.Now()
 	resolver := &net.Resolver{
@@ -403,6 +1036,7 @@ func (d *DNSChecker) Check(ctx context.Context, service config.Service) Result {
 		result.Status = StatusUnhealthy
 		result.Error = fmt.Errorf("DNS resolution failed: %w", err)
 		result.Message = "DNS resolution failed"
+		result.FailureReason = ReasonDNS
 		return result
 	}
 
@@ -410,6 +1044,7 @@ func (d *DNSChecker) Check(ctx context.Context, service config.Service) Result {
 		result.Status = StatusUnhealthy
 		result.Error = fmt.Errorf("no IP addresses found for %s", host)
 		result.Message = "No IP addresses found"
+		result.FailureReason = ReasonDNS
 		return result
 	}
 
@@ -420,7 +1055,8 @@ func (d *DNSChecker) Check(ctx context.Context, service config.Service) Result {
 
 // LatencyChecker checks response latency
 type LatencyChecker struct {
-	client *http.Client
+	client      *http.Client
+	dialTimeout time.Duration // Connection timeout within the client's overall timeout, when set
 }
 
 // NewLatencyChecker creates a new latency checker
@@ -450,49 +1086,31 @@ func (l *LatencyChecker) Check(ctx context.Context, service config.Service) Resu
 		CheckedAt:   time.Now(),
 	}
 
-	// Build URL
-	url := service.URL
-	if service.HealthEndpoint != "" {
-		url = strings.TrimRight(url, "/") + service.HealthEndpoint
-	}
-
-	method := service.Method
-	if method == "" {
-		method = "GET"
-	}
-
-	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	req, err := buildRequest(ctx, service)
 	if err != nil {
 		result.Status = StatusUnhealthy
-		result.Error = fmt.Errorf("failed to create request: %w", err)
+		result.Error = err
 		return result
 	}
 
-	// Add headers and auth (same as HTTPChecker)
-	for key, value := range service.Headers {
-		req.Header.Set(key, value)
-	}
-	if service.Auth != nil {
-		switch strings.ToLower(service.Auth.Type) {
-		case "bearer":
-			if service.Auth.Token != "" {
-				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", service.Auth.Token))
-			}
-		case "basic":
-			if service.Auth.Username != "" && service.Auth.Password != "" {
-				req.SetBasicAuth(service.Auth.Username, service.Auth.Password)
-			}
-		}
+	client, err := clientFor(l.client, l.dialTimeout, service)
+	if err != nil {
+		result.Status = StatusUnhealthy
+		result.Error = err
+		return result
 	}
 
+	req, trace := traceRequest(req)
 	start := time.Now()
-	resp, err := l.client.Do(req)
+	resp, err := client.Do(req)
 	result.ResponseTime = time.Since(start)
+	result.Details = trace.Timing()
 
 	if err != nil {
 		result.Status = StatusUnhealthy
 		result.Error = err
 		result.Message = "Connection failed"
+		result.FailureReason = classifyError(err, ReasonConnect)
 		return result
 	}
 	defer resp.Body.Close()
@@ -509,6 +1127,7 @@ func (l *LatencyChecker) Check(ctx context.Context, service config.Service) Resu
 	if latencyMs > thresholdMs {
 		result.Status = StatusUnhealthy
 		result.Error = fmt.Errorf("latency %dms exceeds threshold of %dms", latencyMs, thresholdMs)
+		result.FailureReason = ReasonLatency
 		return result
 	}
 
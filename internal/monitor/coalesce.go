@@ -0,0 +1,107 @@
+package monitor
+
+import (
+	"context"
+	"sync"
+)
+
+// coalescer buffers the latest undelivered result per service, so a slow
+// Results() consumer sees each service's freshest state instead of a backlog
+type coalescer struct {
+	mu       sync.Mutex
+	pending  map[string]Result
+	order    []string // Services with a pending result, oldest first
+	draining bool
+	stopped  bool
+	quit     chan struct{}
+	wg       sync.WaitGroup
+}
+
+func newCoalescer() *coalescer {
+	return &coalescer{
+		pending: make(map[string]Result),
+		quit:    make(chan struct{}),
+	}
+}
+
+// put replaces any pending result for the service and makes sure a
+// goroutine is delivering pending results to out
+func (c *coalescer) put(ctx context.Context, out chan<- Result, result Result) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.stopped {
+		return
+	}
+	c.add(result)
+
+	if !c.draining {
+		c.draining = true
+		c.wg.Add(1)
+		go c.drain(ctx, out)
+	}
+}
+
+// add queues a result, replacing (but keeping the queue position of) any
+// pending result for the same service. Callers must hold mu.
+func (c *coalescer) add(result Result) {
+	if _, ok := c.pending[result.ServiceName]; !ok {
+		c.order = append(c.order, result.ServiceName)
+	}
+	c.pending[result.ServiceName] = result
+}
+
+// next pops the oldest pending result. Callers must hold mu.
+func (c *coalescer) next() (Result, bool) {
+	if len(c.order) == 0 {
+		return Result{}, false
+	}
+
+	name := c.order[0]
+	c.order = c.order[1:]
+	result := c.pending[name]
+	delete(c.pending, name)
+	return result, true
+}
+
+// drain delivers pending results until none remain or delivery is cancelled
+func (c *coalescer) drain(ctx context.Context, out chan<- Result) {
+	defer c.wg.Done()
+
+	for {
+		c.mu.Lock()
+		result, ok := c.next()
+		if !ok {
+			c.draining = false
+			c.mu.Unlock()
+			return
+		}
+		c.mu.Unlock()
+
+		select {
+		case out <- result:
+		case <-ctx.Done():
+			c.mu.Lock()
+			c.draining = false
+			c.mu.Unlock()
+			return
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+// stop discards pending results and waits for delivery to finish, so out
+// can be closed safely
+func (c *coalescer) stop() {
+	c.mu.Lock()
+	if c.stopped {
+		c.mu.Unlock()
+		return
+	}
+	c.stopped = true
+	close(c.quit)
+	c.mu.Unlock()
+
+	c.wg.Wait()
+}
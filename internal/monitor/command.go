@@ -0,0 +1,116 @@
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/juststeveking/scout/internal/config"
+)
+
+// maxCommandOutput is how much of a command's output is kept as its message
+const maxCommandOutput = 200
+
+// CommandChecker runs a configured command, treating exit code 0 as healthy
+type CommandChecker struct {
+	timeout time.Duration
+	allowed bool // Set by --allow-exec; commands are refused otherwise
+}
+
+// NewCommandChecker creates a command checker; it refuses to run anything
+// unless allowed
+func NewCommandChecker(timeout time.Duration, allowed bool) *CommandChecker {
+	return &CommandChecker{
+		timeout: timeout,
+		allowed: allowed,
+	}
+}
+
+// Check runs the service's command within the timeout
+func (c *CommandChecker) Check(ctx context.Context, service config.Service) Result {
+	result := Result{
+		ServiceName: service.Name,
+		Status:      StatusChecking,
+		CheckedAt:   time.Now(),
+	}
+
+	if !c.allowed {
+		result.Status = StatusUnknown
+		result.Error = fmt.Errorf("command checks are disabled (run scout with --allow-exec)")
+		return result
+	}
+	if len(service.Command) == 0 {
+		result.Status = StatusUnhealthy
+		result.Error = fmt.Errorf("no command configured")
+		return result
+	}
+
+	env := os.Environ()
+	for key, value := range service.Env {
+		resolved, err := config.ResolveValue(value)
+		if err != nil {
+			result.Status = StatusUnhealthy
+			result.Error = fmt.Errorf("failed to resolve env %s: %w", key, err)
+			return result
+		}
+		env = append(env, key+"="+resolved)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var output bytes.Buffer
+	cmd := exec.CommandContext(ctx, service.Command[0], service.Command[1:]...)
+	cmd.Env = env
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	// Don't wait on children that keep the output pipes open after a kill
+	cmd.WaitDelay = time.Second
+
+	start := time.Now()
+	err := cmd.Run()
+	result.ResponseTime = time.Since(start)
+	message := commandOutput(output.String())
+
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+		result.Status = StatusHealthy
+		result.Message = message
+		if result.Message == "" {
+			result.Message = "Exit 0"
+		}
+	case ctx.Err() == context.DeadlineExceeded:
+		result.Status = StatusUnhealthy
+		result.Error = fmt.Errorf("command timed out after %s", c.timeout)
+		result.Message = "Timed out"
+		result.FailureReason = ReasonTimeout
+	case errors.As(err, &exitErr):
+		result.Status = StatusUnhealthy
+		result.Message = fmt.Sprintf("Exit %d", exitErr.ExitCode())
+		result.FailureReason = ReasonStatus
+		if message != "" {
+			result.Message += ": " + message
+		}
+	default:
+		result.Status = StatusUnhealthy
+		result.Error = fmt.Errorf("failed to run command: %w", err)
+	}
+
+	return result
+}
+
+// commandOutput collapses a command's output onto one line for display,
+// truncating long output
+func commandOutput(output string) string {
+	line := strings.Join(strings.Fields(output), " ")
+	if len([]rune(line)) > maxCommandOutput {
+		line = string([]rune(line)[:maxCommandOutput-1]) + "…"
+	}
+	return line
+}
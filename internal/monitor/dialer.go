@@ -0,0 +1,183 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/juststeveking/scout/internal/config"
+	"golang.org/x/net/proxy"
+)
+
+// Dialer opens network connections for checkers
+type Dialer interface {
+	DialContext(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+// dialerFor returns the dialer a service's checks connect with, routing
+// through the service's SOCKS5 proxy when one is configured
+func dialerFor(service config.Service, timeout time.Duration) (Dialer, error) {
+	direct := &net.Dialer{Timeout: timeout}
+	if service.Proxy == "" {
+		return direct, nil
+	}
+
+	raw, err := config.ResolveValue(service.Proxy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve proxy: %w", err)
+	}
+
+	proxyURL, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy: %w", err)
+	}
+	if proxyURL.Scheme != "socks5" && proxyURL.Scheme != "socks5h" {
+		return nil, fmt.Errorf("unsupported proxy scheme %q (expected socks5)", proxyURL.Scheme)
+	}
+
+	var auth *proxy.Auth
+	if proxyURL.User != nil {
+		password, _ := proxyURL.User.Password()
+		auth = &proxy.Auth{User: proxyURL.User.Username(), Password: password}
+	}
+
+	socks, err := proxy.SOCKS5("tcp", proxyURL.Host, auth, direct)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy: %w", err)
+	}
+	dialer, ok := socks.(proxy.ContextDialer)
+	if !ok {
+		return nil, fmt.Errorf("socks5: proxy dialer does not support contexts")
+	}
+
+	// socks5h leaves hostnames for the proxy to resolve
+	if proxyURL.Scheme == "socks5h" {
+		return dialer, nil
+	}
+	return &localResolveDialer{forward: dialer}, nil
+}
+
+// localResolveDialer resolves hostnames before dialing through a socks5://
+// proxy, so the proxy only ever sees IP addresses
+type localResolveDialer struct {
+	forward proxy.ContextDialer
+}
+
+// DialContext resolves address's host and dials each of its addresses
+// through the proxy until one connects
+func (d *localResolveDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	if net.ParseIP(host) != nil {
+		return d.forward.DialContext(ctx, network, address)
+	}
+
+	ips, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastErr error
+	for _, ip := range ips {
+		conn, err := d.forward.DialContext(ctx, network, net.JoinHostPort(ip.String(), port))
+		if err == nil {
+			return conn, nil
+		}
+		lastErr = err
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return nil, lastErr
+}
+
+// dialTransport returns a copy of client's transport whose connections
+// give up after timeout, independently of the client's overall timeout
+func dialTransport(client *http.Client, timeout time.Duration) *http.Transport {
+	base, ok := client.Transport.(*http.Transport)
+	if !ok {
+		base = http.DefaultTransport.(*http.Transport)
+	}
+	transport := base.Clone()
+	transport.DialContext = (&net.Dialer{Timeout: timeout, KeepAlive: 30 * time.Second}).DialContext
+	return transport
+}
+
+// applyDialTimeout bounds how long HTTP, latency and download checks spend
+// connecting, leaving the client timeout to bound the whole request
+func applyDialTimeout(checkers map[string]Checker, timeout time.Duration) {
+	for _, checker := range checkers {
+		switch c := checker.(type) {
+		case *HTTPChecker:
+			c.client.Transport = dialTransport(c.client, timeout)
+			c.dialTimeout = timeout
+		case *LatencyChecker:
+			c.client.Transport = dialTransport(c.client, timeout)
+			c.dialTimeout = timeout
+		case *DownloadChecker:
+			c.client.Transport = dialTransport(c.client, timeout)
+			c.dialTimeout = timeout
+		}
+	}
+}
+
+// proxiedClient returns a copy of client that connects through dialer,
+// keeping its TLS settings. Keep-alives are disabled since the client only
+// lives for one check.
+func proxiedClient(client *http.Client, dialer Dialer) *http.Client {
+	transport := &http.Transport{
+		DialContext:       dialer.DialContext,
+		DisableKeepAlives: true,
+	}
+	if base, ok := client.Transport.(*http.Transport); ok && base.TLSClientConfig != nil {
+		transport.TLSClientConfig = base.TLSClientConfig.Clone()
+	}
+
+	return &http.Client{
+		Timeout:       client.Timeout,
+		CheckRedirect: client.CheckRedirect,
+		Transport:     transport,
+	}
+}
+
+// freshClient returns a copy of client that opens a new connection for
+// every request, so each check includes the full TCP and TLS handshake
+func freshClient(client *http.Client) *http.Client {
+	base, ok := client.Transport.(*http.Transport)
+	if !ok {
+		base = http.DefaultTransport.(*http.Transport)
+	}
+	transport := base.Clone()
+	transport.DisableKeepAlives = true
+
+	return &http.Client{
+		Timeout:       client.Timeout,
+		CheckRedirect: client.CheckRedirect,
+		Transport:     transport,
+	}
+}
+
+// clientFor returns the HTTP client to check a service with. A proxy is
+// reached within dialTimeout, or the client's timeout when it is zero.
+func clientFor(client *http.Client, dialTimeout time.Duration, service config.Service) (*http.Client, error) {
+	if service.Proxy == "" {
+		if !service.ReusesConnections() {
+			return freshClient(client), nil
+		}
+		return client, nil
+	}
+
+	if dialTimeout <= 0 {
+		dialTimeout = client.Timeout
+	}
+	dialer, err := dialerFor(service, dialTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return proxiedClient(client, dialer), nil
+}
@@ -0,0 +1,278 @@
+package monitor
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/juststeveking/scout/internal/config"
+)
+
+// startSOCKS5Stub starts a minimal SOCKS5 proxy requiring the given
+// credentials, returning its address and a count of proxied connections
+func startSOCKS5Stub(t *testing.T, username, password string) (string, *atomic.Int32) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	var connections atomic.Int32
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				target, err := socks5StubHandshake(conn, username, password)
+				if err != nil {
+					return
+				}
+				defer target.Close()
+				connections.Add(1)
+
+				go io.Copy(target, conn)
+				io.Copy(conn, target)
+			}()
+		}
+	}()
+
+	return listener.Addr().String(), &connections
+}
+
+// socks5StubHandshake performs the server side of a SOCKS5 handshake
+func socks5StubHandshake(conn net.Conn, username, password string) (net.Conn, error) {
+	greeting := make([]byte, 2)
+	if _, err := io.ReadFull(conn, greeting); err != nil {
+		return nil, err
+	}
+	if _, err := io.ReadFull(conn, make([]byte, greeting[1])); err != nil {
+		return nil, err
+	}
+	conn.Write([]byte{0x05, 0x02})
+
+	// Username/password sub-negotiation
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return nil, err
+	}
+	user := make([]byte, header[1])
+	io.ReadFull(conn, user)
+	passLen := make([]byte, 1)
+	io.ReadFull(conn, passLen)
+	pass := make([]byte, passLen[0])
+	io.ReadFull(conn, pass)
+	if string(user) != username || string(pass) != password {
+		conn.Write([]byte{0x01, 0x01})
+		return nil, fmt.Errorf("bad credentials")
+	}
+	conn.Write([]byte{0x01, 0x00})
+
+	// CONNECT request
+	request := make([]byte, 4)
+	if _, err := io.ReadFull(conn, request); err != nil {
+		return nil, err
+	}
+	var host string
+	switch request[3] {
+	case 0x01:
+		ip := make([]byte, 4)
+		io.ReadFull(conn, ip)
+		host = net.IP(ip).String()
+	case 0x03:
+		length := make([]byte, 1)
+		io.ReadFull(conn, length)
+		name := make([]byte, length[0])
+		io.ReadFull(conn, name)
+		host = string(name)
+	default:
+		conn.Write([]byte{0x05, 0x08, 0x00, 0x01, 0, 0, 0, 0, 0, 0})
+		return nil, fmt.Errorf("unsupported address type")
+	}
+	port := make([]byte, 2)
+	io.ReadFull(conn, port)
+
+	target, err := net.Dial("tcp", net.JoinHostPort(host, fmt.Sprint(binary.BigEndian.Uint16(port))))
+	if err != nil {
+		conn.Write([]byte{0x05, 0x05, 0x00, 0x01, 0, 0, 0, 0, 0, 0})
+		return nil, err
+	}
+	conn.Write([]byte{0x05, 0x00, 0x00, 0x01, 0, 0, 0, 0, 0, 0})
+	return target, nil
+}
+
+func TestCheckersThroughSOCKS5Proxy(t *testing.T) {
+	proxyAddr, connections := startSOCKS5Stub(t, "scout", "secret")
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	t.Setenv("SCOUT_TEST_PROXY", "socks5://scout:secret@"+proxyAddr)
+
+	httpChecker := NewHTTPChecker(1 * time.Second)
+	defer httpChecker.Close()
+
+	svc := config.Service{
+		Name:           "test-socks-http",
+		URL:            ts.URL,
+		HealthEndpoint: "/health",
+		ExpectedStatus: 200,
+		Proxy:          "${SCOUT_TEST_PROXY}",
+	}
+	result := httpChecker.Check(context.Background(), svc)
+	if result.Status != StatusHealthy {
+		t.Errorf("Expected HTTP check through proxy to be healthy, got %v: %v", result.Status, result.Error)
+	}
+
+	tcpChecker := NewTCPChecker(1 * time.Second)
+	tcpSvc := config.Service{
+		Name:  "test-socks-tcp",
+		URL:   strings.TrimPrefix(ts.URL, "http://"),
+		Type:  "tcp",
+		Proxy: "socks5://scout:secret@" + proxyAddr,
+	}
+	result = tcpChecker.Check(context.Background(), tcpSvc)
+	if result.Status != StatusHealthy {
+		t.Errorf("Expected TCP check through proxy to be healthy, got %v: %v", result.Status, result.Error)
+	}
+
+	if got := connections.Load(); got != 2 {
+		t.Errorf("Expected 2 proxied connections, got %d", got)
+	}
+
+	// Wrong credentials are rejected by the proxy
+	tcpSvc.Proxy = "socks5://scout:wrong@" + proxyAddr
+	result = tcpChecker.Check(context.Background(), tcpSvc)
+	if result.Status != StatusUnhealthy || result.Error == nil || !strings.Contains(result.Error.Error(), "authentication failed") {
+		t.Errorf("Expected authentication failure, got %v: %v", result.Status, result.Error)
+	}
+
+	// Only SOCKS5 proxies are supported
+	tcpSvc.Proxy = "http://" + proxyAddr
+	result = tcpChecker.Check(context.Background(), tcpSvc)
+	if result.Status != StatusUnhealthy || result.Error == nil || !strings.Contains(result.Error.Error(), "unsupported proxy scheme") {
+		t.Errorf("Expected unsupported scheme error, got %v: %v", result.Status, result.Error)
+	}
+}
+
+// recordingDialer records the addresses it's asked to dial and fails them
+type recordingDialer struct {
+	addresses []string
+}
+
+func (d *recordingDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	d.addresses = append(d.addresses, address)
+	return nil, fmt.Errorf("refused")
+}
+
+func TestDialerForProxyResolution(t *testing.T) {
+	// socks5:// resolves hostnames locally and sends the proxy IPs
+	dialer, err := dialerFor(config.Service{Proxy: "socks5://127.0.0.1:1080"}, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	local, ok := dialer.(*localResolveDialer)
+	if !ok {
+		t.Fatalf("Expected socks5 proxy to resolve locally, got %T", dialer)
+	}
+	recorder := &recordingDialer{}
+	local.forward = recorder
+	local.DialContext(context.Background(), "tcp", "localhost:8080")
+	if len(recorder.addresses) == 0 {
+		t.Fatal("Expected the proxy to be dialed")
+	}
+	for _, address := range recorder.addresses {
+		if address != "127.0.0.1:8080" && address != "[::1]:8080" {
+			t.Errorf("Expected a resolved address, got %s", address)
+		}
+	}
+
+	// socks5h:// leaves resolution to the proxy
+	dialer, err = dialerFor(config.Service{Proxy: "socks5h://127.0.0.1:1080"}, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := dialer.(*localResolveDialer); ok {
+		t.Error("Expected socks5h proxy to resolve hostnames remotely")
+	}
+}
+
+// startSilentListener accepts connections but never writes to them, stalling
+// any handshake until the client gives up
+func startSilentListener(t *testing.T) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				io.Copy(io.Discard, conn)
+			}()
+		}
+	}()
+
+	return listener.Addr().String()
+}
+
+func TestCheckersAbortOnCancel(t *testing.T) {
+	addr := startSilentListener(t)
+
+	tests := []struct {
+		name    string
+		checker Checker
+		service config.Service
+	}{
+		{
+			name:    "tls handshake",
+			checker: NewTLSChecker(10 * time.Second),
+			service: config.Service{Name: "stalled-tls", URL: addr, Type: "tls"},
+		},
+		{
+			name:    "tcp through stalled proxy",
+			checker: NewTCPChecker(10 * time.Second),
+			service: config.Service{Name: "stalled-proxy", URL: "db.internal:5432", Type: "tcp", Proxy: "socks5://" + addr},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			time.AfterFunc(50*time.Millisecond, cancel)
+
+			start := time.Now()
+			result := tt.checker.Check(ctx, tt.service)
+			elapsed := time.Since(start)
+
+			if result.Status != StatusUnhealthy {
+				t.Errorf("Expected cancelled check to be unhealthy, got %v", result.Status)
+			}
+			if elapsed > 2*time.Second {
+				t.Errorf("Expected check to abort on cancellation, took %v", elapsed)
+			}
+		})
+	}
+}
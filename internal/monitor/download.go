@@ -0,0 +1,149 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/juststeveking/scout/internal/config"
+)
+
+// defaultMaxDownloadMB caps how much of a body download checks read when
+// max_download_mb isn't set
+const defaultMaxDownloadMB = 10
+
+// DownloadChecker measures the sustained transfer rate of a response body,
+// for CDN and file-serving endpoints where throughput matters more than
+// time to first byte
+type DownloadChecker struct {
+	client      *http.Client
+	dialTimeout time.Duration // Connection timeout within the client's overall timeout, when set
+}
+
+// NewDownloadChecker creates a new download checker
+func NewDownloadChecker(timeout time.Duration) *DownloadChecker {
+	return &DownloadChecker{
+		client: &http.Client{
+			Timeout: timeout,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// Close closes the HTTP client
+func (d *DownloadChecker) Close() {
+	if d.client != nil && d.client.Transport != nil {
+		d.client.CloseIdleConnections()
+	}
+}
+
+// Check downloads up to max_download_mb of the body and compares the
+// transfer rate, measured from the first response byte, with min_throughput
+func (d *DownloadChecker) Check(ctx context.Context, service config.Service) Result {
+	result := Result{
+		ServiceName: service.Name,
+		Status:      StatusChecking,
+		CheckedAt:   time.Now(),
+	}
+
+	req, err := buildRequest(ctx, service)
+	if err != nil {
+		result.Status = StatusUnhealthy
+		result.Error = err
+		return result
+	}
+
+	// Measure bytes on the wire rather than after transparent decompression
+	if req.Header.Get("Accept-Encoding") == "" {
+		req.Header.Set("Accept-Encoding", "identity")
+	}
+
+	client, err := clientFor(d.client, d.dialTimeout, service)
+	if err != nil {
+		result.Status = StatusUnhealthy
+		result.Error = err
+		return result
+	}
+
+	req, trace := traceRequest(req)
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		result.ResponseTime = time.Since(start)
+		result.Details = trace.Timing()
+		result.Status = StatusUnhealthy
+		result.Error = err
+		result.Message = "Connection failed"
+		result.FailureReason = classifyError(err, ReasonConnect)
+		return result
+	}
+	defer resp.Body.Close()
+
+	result.StatusCode = resp.StatusCode
+	expectedStatus := service.ExpectedStatus
+	if expectedStatus == 0 {
+		expectedStatus = http.StatusOK
+	}
+	if resp.StatusCode != expectedStatus {
+		result.ResponseTime = time.Since(start)
+		result.Details = trace.Timing()
+		result.Status = StatusUnhealthy
+		result.Message = fmt.Sprintf("Expected %d, got %d", expectedStatus, resp.StatusCode)
+		result.FailureReason = ReasonStatus
+		return result
+	}
+
+	limit := int64(service.MaxDownloadMB) * 1000 * 1000
+	if limit <= 0 {
+		limit = defaultMaxDownloadMB * 1000 * 1000
+	}
+
+	transferStart := time.Now()
+	n, err := io.Copy(io.Discard, io.LimitReader(resp.Body, limit))
+	elapsed := time.Since(transferStart)
+	result.ResponseTime = time.Since(start)
+	result.Details = trace.Timing()
+
+	if err != nil {
+		result.Status = StatusUnhealthy
+		result.Error = fmt.Errorf("download failed after %s: %w", formatBytes(n), err)
+		result.FailureReason = classifyError(err, ReasonConnect)
+		return result
+	}
+	if n == 0 {
+		result.Status = StatusUnhealthy
+		result.Error = fmt.Errorf("response body is empty, nothing to measure")
+		result.FailureReason = ReasonAssertion
+		return result
+	}
+
+	throughput := float64(n) / 1e6 / max(elapsed.Seconds(), time.Microsecond.Seconds())
+	result.Message = fmt.Sprintf("Throughput: %.1f MB/s (%s)", throughput, formatBytes(n))
+
+	if service.MinThroughput > 0 && throughput < service.MinThroughput {
+		result.Status = StatusUnhealthy
+		result.Error = fmt.Errorf("throughput %.1f MB/s is below the minimum of %s MB/s", throughput, strconv.FormatFloat(service.MinThroughput, 'f', -1, 64))
+		result.FailureReason = ReasonLatency
+		return result
+	}
+
+	result.Status = StatusHealthy
+	return result
+}
+
+// formatBytes formats a byte count in decimal units, e.g. "10.0 MB"
+func formatBytes(n int64) string {
+	switch {
+	case n >= 1e6:
+		return fmt.Sprintf("%.1f MB", float64(n)/1e6)
+	case n >= 1e3:
+		return fmt.Sprintf("%.1f kB", float64(n)/1e3)
+	default:
+		return fmt.Sprintf("%d B", n)
+	}
+}
@@ -0,0 +1,91 @@
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/juststeveking/scout/internal/config"
+)
+
+func TestDownloadChecker(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/large":
+			w.Write(bytes.Repeat([]byte("x"), 3_000_000))
+		case "/slow":
+			// About 10kB every 20ms, well under 1 MB/s
+			for i := 0; i < 5; i++ {
+				w.Write(bytes.Repeat([]byte("x"), 10_000))
+				w.(http.Flusher).Flush()
+				time.Sleep(20 * time.Millisecond)
+			}
+		case "/empty":
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	checker := NewDownloadChecker(2 * time.Second)
+	defer checker.Close()
+
+	tests := []struct {
+		name        string
+		service     config.Service
+		wantStatus  Status
+		wantReason  FailureReason
+		wantMessage string
+	}{
+		{
+			name:        "capped download",
+			service:     config.Service{HealthEndpoint: "/large", MaxDownloadMB: 1},
+			wantStatus:  StatusHealthy,
+			wantMessage: "(1.0 MB)",
+		},
+		{
+			name:        "full download",
+			service:     config.Service{HealthEndpoint: "/large", MinThroughput: 0.01},
+			wantStatus:  StatusHealthy,
+			wantMessage: "(3.0 MB)",
+		},
+		{
+			name:        "below minimum throughput",
+			service:     config.Service{HealthEndpoint: "/slow", MinThroughput: 100},
+			wantStatus:  StatusUnhealthy,
+			wantReason:  ReasonLatency,
+			wantMessage: "(50.0 kB)",
+		},
+		{
+			name:       "empty body",
+			service:    config.Service{HealthEndpoint: "/empty"},
+			wantStatus: StatusUnhealthy,
+			wantReason: ReasonAssertion,
+		},
+		{
+			name:        "unexpected status",
+			service:     config.Service{HealthEndpoint: "/missing"},
+			wantStatus:  StatusUnhealthy,
+			wantReason:  ReasonStatus,
+			wantMessage: "Expected 200, got 404",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.service.Name = "test-download"
+			tt.service.URL = ts.URL
+			result := checker.Check(context.Background(), tt.service)
+			if result.Status != tt.wantStatus || result.FailureReason != tt.wantReason {
+				t.Errorf("Expected %v (%q), got %v (%q): %v", tt.wantStatus, tt.wantReason, result.Status, result.FailureReason, result.Error)
+			}
+			if !strings.Contains(result.Message, tt.wantMessage) {
+				t.Errorf("Expected message containing %q, got %q", tt.wantMessage, result.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/juststeveking/scout/internal/config"
+)
+
+// EndpointResult is the outcome of one of a service's endpoint sub-checks
+type EndpointResult struct {
+	Path         string
+	Status       Status
+	StatusCode   int
+	ResponseTime time.Duration
+	Message      string
+	Error        error
+}
+
+// endpointChecker runs a service's checker once per configured endpoint and
+// aggregates the outcomes into a single result
+type endpointChecker struct {
+	checker Checker
+}
+
+// Check performs every endpoint check concurrently
+func (e endpointChecker) Check(ctx context.Context, service config.Service) Result {
+	results := make([]Result, len(service.Endpoints))
+
+	var wg sync.WaitGroup
+	for i, endpoint := range service.Endpoints {
+		wg.Add(1)
+		go func(i int, endpoint config.Endpoint) {
+			defer wg.Done()
+			results[i] = e.checker.Check(ctx, endpointService(service, endpoint))
+		}(i, endpoint)
+	}
+	wg.Wait()
+
+	return aggregateEndpoints(service, results)
+}
+
+// endpointService derives the service checked for a single endpoint; the
+// endpoint's own expectations replace the service's response checks, and its
+// auth and headers take precedence over the service's
+func endpointService(service config.Service, endpoint config.Endpoint) config.Service {
+	service.HealthEndpoint = endpoint.Path
+	service.ExpectedStatus = endpoint.ExpectedStatus
+	service.JSONAssertions = endpoint.JSONAssertions
+	service.JSONSchema = ""
+	service.GoldenFile = ""
+	service.IgnorePaths = nil
+	service.ExpectedContentType = ""
+	service.ExpectedLocation = ""
+	service.HeaderAssertions = nil
+	service.Endpoints = nil
+
+	if endpoint.Auth != nil {
+		service.Auth = endpoint.Auth
+	}
+	if len(endpoint.Headers) > 0 {
+		service.Headers = mergeHeaders(service.Headers, endpoint.Headers)
+	}
+	return service
+}
+
+// mergeHeaders returns base with overrides applied, matching header names
+// case-insensitively so an override replaces rather than duplicates
+func mergeHeaders(base, overrides map[string]string) map[string]string {
+	merged := make(map[string]string, len(base)+len(overrides))
+	for name, value := range base {
+		merged[name] = value
+	}
+	for name, value := range overrides {
+		for existing := range merged {
+			if strings.EqualFold(existing, name) {
+				delete(merged, existing)
+			}
+		}
+		merged[name] = value
+	}
+	return merged
+}
+
+// aggregateEndpoints combines endpoint results, reporting the first failing
+// endpoint's details when any of them fail
+func aggregateEndpoints(service config.Service, results []Result) Result {
+	aggregate := Result{
+		ServiceName: service.Name,
+		Status:      StatusHealthy,
+		Endpoints:   make([]EndpointResult, len(results)),
+	}
+
+	var failing []string
+	var firstFailure *Result
+	for i := range results {
+		r := &results[i]
+		path := service.Endpoints[i].Path
+
+		aggregate.Endpoints[i] = EndpointResult{
+			Path:         path,
+			Status:       r.Status,
+			StatusCode:   r.StatusCode,
+			ResponseTime: r.ResponseTime,
+			Message:      r.Message,
+			Error:        r.Error,
+		}
+
+		// Endpoints run concurrently, so the slowest one is the check's latency
+		if r.ResponseTime > aggregate.ResponseTime {
+			aggregate.ResponseTime = r.ResponseTime
+		}
+		if r.RetryAfter > aggregate.RetryAfter {
+			aggregate.RetryAfter = r.RetryAfter
+		}
+		if aggregate.CheckedAt.IsZero() || r.CheckedAt.Before(aggregate.CheckedAt) {
+			aggregate.CheckedAt = r.CheckedAt
+		}
+		if aggregate.Fields == nil {
+			aggregate.Fields = r.Fields
+		}
+
+		if r.Status != StatusHealthy {
+			failing = append(failing, path)
+			if firstFailure == nil {
+				firstFailure = r
+			}
+		}
+	}
+
+	if firstFailure == nil {
+		aggregate.StatusCode = results[0].StatusCode
+		aggregate.Capture = results[0].Capture
+		aggregate.Message = fmt.Sprintf("%d/%d endpoints healthy", len(results), len(results))
+		return aggregate
+	}
+
+	aggregate.Status = StatusUnhealthy
+	aggregate.StatusCode = firstFailure.StatusCode
+	aggregate.FailureReason = firstFailure.FailureReason
+	aggregate.Capture = firstFailure.Capture
+	if firstFailure.Error != nil {
+		aggregate.Error = fmt.Errorf("%s: %w", failing[0], firstFailure.Error)
+	}
+
+	if len(failing) == 1 {
+		aggregate.Message = failing[0]
+		if firstFailure.Message != "" {
+			aggregate.Message += ": " + firstFailure.Message
+		}
+	} else {
+		aggregate.Message = fmt.Sprintf("%d/%d endpoints failing: %s", len(failing), len(results), strings.Join(failing, ", "))
+	}
+	return aggregate
+}
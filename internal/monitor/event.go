@@ -0,0 +1,56 @@
+package monitor
+
+import (
+	"errors"
+	"time"
+)
+
+// Event is the stable, single-line JSON schema emitted for each completed
+// check result when running with --events
+type Event struct {
+	Service        string        `json:"service"`         // Service name as configured
+	Status         Status        `json:"status"`          // "healthy", "unhealthy", or "unknown"
+	PreviousStatus Status        `json:"previous_status"` // Status before this check ("unknown" on the first check)
+	LatencyMs      float64       `json:"latency_ms"`      // Response time in milliseconds
+	StatusCode     int           `json:"status_code"`     // HTTP status code, or 0 for non-HTTP checks
+	Error          string        `json:"error"`           // Error message, or empty when the check passed
+	FailureReason  FailureReason `json:"failure_reason"`  // Failure category such as "timeout" or "status", or empty when the check passed
+	Timestamp      time.Time     `json:"timestamp"`       // Time the check ran (RFC 3339)
+}
+
+// NewEvent converts a check result into an Event
+func NewEvent(result Result) Event {
+	event := Event{
+		Service:        result.ServiceName,
+		Status:         result.Status,
+		PreviousStatus: result.PreviousStatus,
+		LatencyMs:      float64(result.ResponseTime.Microseconds()) / 1000,
+		StatusCode:     result.StatusCode,
+		FailureReason:  result.FailureReason,
+		Timestamp:      result.CheckedAt,
+	}
+	if event.PreviousStatus == "" {
+		event.PreviousStatus = StatusUnknown
+	}
+	if result.Error != nil {
+		event.Error = result.Error.Error()
+	}
+	return event
+}
+
+// Result converts a recorded Event back into a check result, for replaying
+func (e Event) Result() Result {
+	result := Result{
+		ServiceName:    e.Service,
+		Status:         e.Status,
+		PreviousStatus: e.PreviousStatus,
+		ResponseTime:   time.Duration(e.LatencyMs * float64(time.Millisecond)),
+		StatusCode:     e.StatusCode,
+		FailureReason:  e.FailureReason,
+		CheckedAt:      e.Timestamp,
+	}
+	if e.Error != "" {
+		result.Error = errors.New(e.Error)
+	}
+	return result
+}
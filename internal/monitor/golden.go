@@ -0,0 +1,196 @@
+package monitor
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// compareGolden checks a JSON response against the document in a golden
+// file, after removing the ignored paths from both, and returns the first
+// difference found. The file is read on every check so updates are picked up.
+func compareGolden(body []byte, goldenFile string, ignorePaths []string) error {
+	data, err := os.ReadFile(goldenFile)
+	if err != nil {
+		return fmt.Errorf("failed to read golden file: %w", err)
+	}
+
+	var expected, actual interface{}
+	if err := json.Unmarshal(data, &expected); err != nil {
+		return fmt.Errorf("golden file is not valid JSON: %w", err)
+	}
+	if err := json.Unmarshal(body, &actual); err != nil {
+		return fmt.Errorf("response is not valid JSON: %w", err)
+	}
+
+	for _, path := range ignorePaths {
+		segments := splitPath(path)
+		expected = removePath(expected, segments)
+		actual = removePath(actual, segments)
+	}
+
+	if err := diffJSON(expected, actual, "$"); err != nil {
+		return fmt.Errorf("response differs from golden file: %w", err)
+	}
+	return nil
+}
+
+// splitPath splits a dotted gjson-style path such as "meta.request_id" or
+// "items.#.updated_at" into its segments, honouring \. escapes
+func splitPath(path string) []string {
+	var segments []string
+	var current strings.Builder
+	for i := 0; i < len(path); i++ {
+		switch {
+		case path[i] == '\\' && i+1 < len(path):
+			i++
+			current.WriteByte(path[i])
+		case path[i] == '.':
+			segments = append(segments, current.String())
+			current.Reset()
+		default:
+			current.WriteByte(path[i])
+		}
+	}
+	return append(segments, current.String())
+}
+
+// removePath deletes the value at a path from a decoded JSON document.
+// A "#" or "*" segment matches every array element or object member.
+func removePath(value interface{}, segments []string) interface{} {
+	if len(segments) == 0 {
+		return value
+	}
+	segment, rest := segments[0], segments[1:]
+	wildcard := segment == "#" || segment == "*"
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if !wildcard && key != segment {
+				continue
+			}
+			if len(rest) == 0 {
+				delete(v, key)
+			} else {
+				v[key] = removePath(child, rest)
+			}
+		}
+	case []interface{}:
+		if wildcard {
+			if len(rest) == 0 {
+				return []interface{}{}
+			}
+			for i, child := range v {
+				v[i] = removePath(child, rest)
+			}
+			return v
+		}
+		i, err := strconv.Atoi(segment)
+		if err != nil || i < 0 || i >= len(v) {
+			return v
+		}
+		if len(rest) == 0 {
+			return append(v[:i:i], v[i+1:]...)
+		}
+		v[i] = removePath(v[i], rest)
+	}
+	return value
+}
+
+// diffJSON returns the first difference between two decoded JSON values,
+// path naming the value being compared
+func diffJSON(expected, actual interface{}, path string) error {
+	if jsonType(expected) != jsonType(actual) && !(isNumber(expected) && isNumber(actual)) {
+		return fmt.Errorf("%s: expected %s, got %s", path, jsonType(expected), jsonType(actual))
+	}
+
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		a := actual.(map[string]interface{})
+
+		// Sort keys so the first difference reported is stable
+		keys := make([]string, 0, len(e)+len(a))
+		for key := range e {
+			keys = append(keys, key)
+		}
+		for key := range a {
+			if _, ok := e[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			childPath := path + "." + key
+			expectedChild, inExpected := e[key]
+			actualChild, inActual := a[key]
+			switch {
+			case !inActual:
+				return fmt.Errorf("%s: missing", childPath)
+			case !inExpected:
+				return fmt.Errorf("%s: unexpected property", childPath)
+			}
+			if err := diffJSON(expectedChild, actualChild, childPath); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		a := actual.([]interface{})
+		if len(e) != len(a) {
+			return fmt.Errorf("%s: expected %d items, got %d", path, len(e), len(a))
+		}
+		for i := range e {
+			if err := diffJSON(e[i], a[i], fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	default:
+		if !reflect.DeepEqual(expected, actual) {
+			return fmt.Errorf("%s: expected %s, got %s", path, formatJSON(expected), formatJSON(actual))
+		}
+	}
+	return nil
+}
+
+// isNumber reports whether a decoded JSON value is a number
+func isNumber(value interface{}) bool {
+	_, ok := value.(float64)
+	return ok
+}
+
+// jsonType names a decoded value's JSON type, distinguishing integers
+func jsonType(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		if v == math.Trunc(v) {
+			return "integer"
+		}
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// formatJSON renders a scalar as it appears in JSON, so "1" and 1 differ
+func formatJSON(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
@@ -0,0 +1,89 @@
+package monitor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/juststeveking/scout/internal/config"
+)
+
+const testGolden = `{
+	"status": "ok",
+	"version": "1.4.0",
+	"timestamp": "2024-01-01T00:00:00Z",
+	"meta": {"request_id": "abc", "region": "eu"},
+	"checks": [{"name": "db", "checked_at": 1}, {"name": "cache", "checked_at": 2}]
+}`
+
+func TestCompareGolden(t *testing.T) {
+	goldenPath := filepath.Join(t.TempDir(), "golden.json")
+	if err := os.WriteFile(goldenPath, []byte(testGolden), 0644); err != nil {
+		t.Fatal(err)
+	}
+	ignore := []string{"timestamp", "meta.request_id", "checks.#.checked_at"}
+
+	tests := []struct {
+		name string
+		body string
+		err  string
+	}{
+		{"matches ignoring volatile values", `{"status":"ok","version":"1.4.0","timestamp":"now","meta":{"request_id":"xyz","region":"eu"},"checks":[{"name":"db","checked_at":9},{"name":"cache","checked_at":8}]}`, ""},
+		{"changed value", `{"status":"ok","version":"1.5.0","meta":{"region":"eu"},"checks":[{"name":"db"},{"name":"cache"}]}`, `$.version: expected "1.4.0", got "1.5.0"`},
+		{"missing property", `{"status":"ok","version":"1.4.0","checks":[{"name":"db"},{"name":"cache"}]}`, "$.meta: missing"},
+		{"extra property", `{"status":"ok","version":"1.4.0","debug":true,"meta":{"region":"eu"},"checks":[{"name":"db"},{"name":"cache"}]}`, "$.debug: unexpected property"},
+		{"array length", `{"status":"ok","version":"1.4.0","meta":{"region":"eu"},"checks":[{"name":"db"}]}`, "$.checks: expected 2 items, got 1"},
+		{"type change", `{"status":"ok","version":"1.4.0","meta":{"region":"eu"},"checks":[{"name":"db"},{"name":7}]}`, "$.checks[1].name: expected string, got integer"},
+		{"not json", `<html>`, "response is not valid JSON"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := compareGolden([]byte(tt.body), goldenPath, ignore)
+			if tt.err == "" {
+				if err != nil {
+					t.Errorf("Expected match, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("Expected error containing %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestHTTPCheckerWithGoldenFile(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"ok","served_at":"12:00:01"}`))
+	}))
+	defer ts.Close()
+
+	goldenPath := filepath.Join(t.TempDir(), "golden.json")
+	if err := os.WriteFile(goldenPath, []byte(`{"status":"ok","served_at":"09:30:00"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	checker := NewHTTPChecker(1 * time.Second)
+	defer checker.Close()
+
+	svc := config.Service{Name: "test-golden", URL: ts.URL, GoldenFile: goldenPath}
+	result := checker.Check(context.Background(), svc)
+	if result.Status != StatusUnhealthy {
+		t.Fatalf("Expected status unhealthy, got %v", result.Status)
+	}
+	if result.Error == nil || !strings.Contains(result.Error.Error(), "$.served_at") {
+		t.Errorf("Expected error naming the differing path, got %v", result.Error)
+	}
+
+	svc.IgnorePaths = []string{"served_at"}
+	if result := checker.Check(context.Background(), svc); result.Status != StatusHealthy {
+		t.Errorf("Expected status healthy with served_at ignored, got %v: %v", result.Status, result.Error)
+	}
+}
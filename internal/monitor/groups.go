@@ -0,0 +1,84 @@
+package monitor
+
+import (
+	"sort"
+
+	"github.com/juststeveking/scout/internal/config"
+)
+
+// GroupHealth is the aggregate health of the services sharing a group
+type GroupHealth struct {
+	Name   string
+	Status Status // Healthy at or above quorum, degraded below it, unhealthy when no member is up
+	Up     int    // Members whose last check was healthy
+	Total  int    // Enabled, unpaused members
+	Quorum int
+}
+
+// evaluateGroup derives a group's health from its members' last completed
+// statuses. A quorum of zero means a majority of the members.
+func evaluateGroup(name string, quorum int, statuses []Status) GroupHealth {
+	health := GroupHealth{Name: name, Total: len(statuses), Quorum: quorum}
+	if health.Quorum <= 0 {
+		health.Quorum = len(statuses)/2 + 1
+	}
+
+	checked := 0
+	for _, status := range statuses {
+		switch status {
+		case StatusHealthy:
+			health.Up++
+			checked++
+		case StatusUnhealthy, StatusStalled:
+			checked++
+		}
+	}
+
+	switch {
+	case checked == 0:
+		health.Status = StatusUnknown
+	case health.Up >= health.Quorum:
+		health.Status = StatusHealthy
+	case health.Up > 0:
+		health.Status = StatusDegraded
+	default:
+		health.Status = StatusUnhealthy
+	}
+	return health
+}
+
+// Groups returns the health of each group configured under groups, sorted
+// by name, from its members' most recent completed checks
+func (m *Monitor) Groups() []GroupHealth {
+	m.muConfigLock.RLock()
+	quorums := make(map[string]int, len(m.Config.Groups))
+	for name, group := range m.Config.Groups {
+		quorums[name] = group.Quorum
+	}
+	m.muConfigLock.RUnlock()
+
+	if len(quorums) == 0 {
+		return nil
+	}
+
+	var services []config.Service
+	for _, service := range m.Services() {
+		if _, ok := quorums[service.Group]; ok && service.IsEnabled() && !m.IsPaused(service.Name) {
+			services = append(services, service)
+		}
+	}
+
+	members := make(map[string][]Status, len(quorums))
+	m.muStatusLock.RLock()
+	for _, service := range services {
+		members[service.Group] = append(members[service.Group], m.serviceStatuses[service.Name])
+	}
+	m.muStatusLock.RUnlock()
+
+	groups := make([]GroupHealth, 0, len(quorums))
+	for name, quorum := range quorums {
+		groups = append(groups, evaluateGroup(name, quorum, members[name]))
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Name < groups[j].Name })
+	return groups
+}
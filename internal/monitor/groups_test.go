@@ -0,0 +1,76 @@
+package monitor
+
+import (
+	"testing"
+
+	"github.com/juststeveking/scout/internal/config"
+)
+
+func TestEvaluateGroup(t *testing.T) {
+	tests := []struct {
+		name     string
+		quorum   int
+		statuses []Status
+		expected Status
+		quorumOf int
+	}{
+		{"all up", 2, []Status{StatusHealthy, StatusHealthy, StatusHealthy}, StatusHealthy, 2},
+		{"at quorum", 2, []Status{StatusHealthy, StatusHealthy, StatusUnhealthy}, StatusHealthy, 2},
+		{"below quorum", 2, []Status{StatusHealthy, StatusUnhealthy, StatusUnhealthy}, StatusDegraded, 2},
+		{"all down", 2, []Status{StatusUnhealthy, StatusStalled, StatusUnhealthy}, StatusUnhealthy, 2},
+		{"majority by default", 0, []Status{StatusHealthy, StatusHealthy, StatusUnhealthy, StatusUnhealthy}, StatusDegraded, 3},
+		{"not yet checked", 0, []Status{StatusUnknown, StatusUnknown}, StatusUnknown, 2},
+		{"no members", 1, nil, StatusUnknown, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			health := evaluateGroup("web", tt.quorum, tt.statuses)
+			if health.Status != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, health.Status)
+			}
+			if health.Quorum != tt.quorumOf {
+				t.Errorf("Expected quorum %d, got %d", tt.quorumOf, health.Quorum)
+			}
+		})
+	}
+}
+
+func TestMonitorGroups(t *testing.T) {
+	notificationsEnabled := false
+	cfg := &config.Config{
+		CheckInterval: "30s",
+		Timeout:       "1s",
+		RetryAttempts: 1,
+		Notifications: config.Notifications{Enabled: &notificationsEnabled},
+		Groups:        map[string]config.Group{"web": {Quorum: 2}},
+		Services: []config.Service{
+			{Name: "web-1", URL: "http://localhost", Group: "web"},
+			{Name: "web-2", URL: "http://localhost", Group: "web"},
+			{Name: "web-3", URL: "http://localhost", Group: "web"},
+			{Name: "db", URL: "http://localhost", Group: "data"},
+		},
+	}
+
+	mon, err := NewMonitor(cfg)
+	if err != nil {
+		t.Fatalf("NewMonitor failed: %v", err)
+	}
+	mon.serviceStatuses["web-1"] = StatusHealthy
+	mon.serviceStatuses["web-2"] = StatusUnhealthy
+	mon.serviceStatuses["web-3"] = StatusHealthy
+
+	groups := mon.Groups()
+	if len(groups) != 1 {
+		t.Fatalf("Expected only the configured group, got %+v", groups)
+	}
+	if groups[0].Status != StatusHealthy || groups[0].Up != 2 || groups[0].Total != 3 {
+		t.Errorf("Expected web healthy with 2/3 up, got %+v", groups[0])
+	}
+
+	// Paused members don't count towards the group
+	mon.PauseService("web-3")
+	if groups := mon.Groups(); groups[0].Status != StatusDegraded || groups[0].Total != 2 {
+		t.Errorf("Expected web degraded with web-3 paused, got %+v", groups[0])
+	}
+}
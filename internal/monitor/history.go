@@ -0,0 +1,115 @@
+package monitor
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultHistorySize is the number of completed results kept per service
+const DefaultHistorySize = 1000
+
+// latencyEMAWeight is the weight of each new response time in a service's
+// exponential moving average latency
+const latencyEMAWeight = 0.3
+
+// History keeps a bounded, in-memory record of recent results per service
+type History struct {
+	mu      sync.RWMutex
+	size    int
+	results map[string][]Result
+	healthy map[string]int // Healthy results currently retained per service
+	ema     map[string]time.Duration
+}
+
+// NewHistory creates a history that keeps up to size results per service
+func NewHistory(size int) *History {
+	if size < 1 {
+		size = DefaultHistorySize
+	}
+	return &History{
+		size:    size,
+		results: make(map[string][]Result),
+		healthy: make(map[string]int),
+		ema:     make(map[string]time.Duration),
+	}
+}
+
+// Add records a result, evicting the oldest once the service is at capacity
+func (h *History) Add(result Result) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	name := result.ServiceName
+	results := append(h.results[name], result)
+	if result.Status == StatusHealthy {
+		h.healthy[name]++
+	}
+	if result.ResponseTime > 0 {
+		if ema, ok := h.ema[name]; ok {
+			h.ema[name] = ema + time.Duration(latencyEMAWeight*float64(result.ResponseTime-ema))
+		} else {
+			h.ema[name] = result.ResponseTime
+		}
+	}
+	if len(results) > h.size {
+		for _, evicted := range results[:len(results)-h.size] {
+			if evicted.Status == StatusHealthy {
+				h.healthy[name]--
+			}
+		}
+		results = results[len(results)-h.size:]
+	}
+	h.results[name] = results
+}
+
+// Availability returns the percentage of healthy results across all
+// services, and false if nothing has been recorded yet
+func (h *History) Availability() (float64, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	total, healthy := 0, 0
+	for name, results := range h.results {
+		total += len(results)
+		healthy += h.healthy[name]
+	}
+	if total == 0 {
+		return 0, false
+	}
+	return float64(healthy) / float64(total) * 100, true
+}
+
+// Results returns a copy of the recorded results for a service, oldest first
+func (h *History) Results(serviceName string) []Result {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	results := make([]Result, len(h.results[serviceName]))
+	copy(results, h.results[serviceName])
+	return results
+}
+
+// LatencyEMA returns a service's exponential moving average response time,
+// and false before any response time has been recorded
+func (h *History) LatencyEMA(serviceName string) (time.Duration, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	ema, ok := h.ema[serviceName]
+	return ema, ok
+}
+
+// Len returns the number of recorded results for a service
+func (h *History) Len(serviceName string) int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.results[serviceName])
+}
+
+// Remove forgets all results for a service
+func (h *History) Remove(serviceName string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.results, serviceName)
+	delete(h.healthy, serviceName)
+	delete(h.ema, serviceName)
+}
@@ -0,0 +1,201 @@
+package monitor
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Incident is a period a service spent unhealthy, from the check that
+// failed to the check that recovered
+type Incident struct {
+	ServiceName string    `json:"service"`
+	Started     time.Time `json:"started"`
+	Ended       time.Time `json:"ended,omitempty"` // Zero while ongoing
+	Message     string    `json:"message,omitempty"`
+}
+
+// Ongoing reports whether the service hasn't recovered yet
+func (i Incident) Ongoing() bool {
+	return i.Ended.IsZero()
+}
+
+// Duration returns how long the incident lasted, or has lasted so far
+func (i Incident) Duration(now time.Time) time.Duration {
+	if i.Ongoing() {
+		return now.Sub(i.Started)
+	}
+	return i.Ended.Sub(i.Started)
+}
+
+// IncidentLog tracks each service's current and most recent incidents,
+// optionally appending resolved incidents to a JSON lines file
+type IncidentLog struct {
+	mu      sync.RWMutex
+	current map[string]Incident
+	last    map[string]Incident
+	path    string // Set by Persist
+}
+
+// NewIncidentLog creates an empty, in-memory incident log
+func NewIncidentLog() *IncidentLog {
+	return &IncidentLog{
+		current: make(map[string]Incident),
+		last:    make(map[string]Incident),
+	}
+}
+
+// Observe opens an incident when a service turns unhealthy and resolves it
+// when the service is healthy again. Other statuses, such as unknown while
+// checking, leave an open incident running.
+func (l *IncidentLog) Observe(result Result) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	name := result.ServiceName
+	incident, open := l.current[name]
+	switch {
+	case result.Status == StatusUnhealthy && !open:
+		l.current[name] = Incident{
+			ServiceName: name,
+			Started:     result.CheckedAt,
+			Message:     result.Message,
+		}
+	case result.Status == StatusHealthy && open:
+		incident.Ended = result.CheckedAt
+		delete(l.current, name)
+		l.last[name] = incident
+		if l.path != "" {
+			_ = appendIncident(l.path, incident)
+		}
+	}
+}
+
+// Current returns a service's ongoing incident
+func (l *IncidentLog) Current(serviceName string) (Incident, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	incident, ok := l.current[serviceName]
+	return incident, ok
+}
+
+// Last returns a service's most recently resolved incident
+func (l *IncidentLog) Last(serviceName string) (Incident, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	incident, ok := l.last[serviceName]
+	return incident, ok
+}
+
+// Persist appends resolved incidents to path from now on, first loading the
+// incidents already recorded there so the last incident survives restarts
+func (l *IncidentLog) Persist(path string) error {
+	incidents, err := LoadIncidents(path)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, incident := range incidents {
+		if previous, ok := l.last[incident.ServiceName]; !ok || incident.Ended.After(previous.Ended) {
+			l.last[incident.ServiceName] = incident
+		}
+	}
+	l.path = path
+	return nil
+}
+
+// appendIncident writes a resolved incident as one JSON line
+func appendIncident(path string, incident Incident) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create incidents directory: %w", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open incidents file: %w", err)
+	}
+	defer f.Close()
+
+	return json.NewEncoder(f).Encode(incident)
+}
+
+// LoadIncidents reads the resolved incidents recorded at path, oldest first.
+// A missing file has no incidents.
+func LoadIncidents(path string) ([]Incident, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open incidents file: %w", err)
+	}
+	defer f.Close()
+
+	var incidents []Incident
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var incident Incident
+		if err := json.Unmarshal(scanner.Bytes(), &incident); err != nil {
+			return nil, fmt.Errorf("invalid incident on line %d of %s: %w", line, path, err)
+		}
+		incidents = append(incidents, incident)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read incidents file: %w", err)
+	}
+	return incidents, nil
+}
+
+// IncidentSummary aggregates a service's resolved incidents
+type IncidentSummary struct {
+	ServiceName string
+	Count       int
+	Longest     time.Duration
+	MTTR        time.Duration // Mean time to recovery
+	Last        Incident
+}
+
+// SummarizeIncidents aggregates resolved incidents per service, in order of
+// each service's first incident
+func SummarizeIncidents(incidents []Incident) []IncidentSummary {
+	var summaries []IncidentSummary
+	index := make(map[string]int)
+	totals := make(map[string]time.Duration)
+
+	for _, incident := range incidents {
+		if incident.Ongoing() {
+			continue
+		}
+		i, exists := index[incident.ServiceName]
+		if !exists {
+			i = len(summaries)
+			index[incident.ServiceName] = i
+			summaries = append(summaries, IncidentSummary{ServiceName: incident.ServiceName})
+		}
+
+		duration := incident.Duration(incident.Ended)
+		s := &summaries[i]
+		s.Count++
+		totals[s.ServiceName] += duration
+		if duration > s.Longest {
+			s.Longest = duration
+		}
+		if incident.Ended.After(s.Last.Ended) {
+			s.Last = incident
+		}
+	}
+
+	for i := range summaries {
+		summaries[i].MTTR = totals[summaries[i].ServiceName] / time.Duration(summaries[i].Count)
+	}
+	return summaries
+}
@@ -0,0 +1,78 @@
+package monitor
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestIncidentLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "incidents.jsonl")
+	log := NewIncidentLog()
+	if err := log.Persist(path); err != nil {
+		t.Fatalf("Persist failed: %v", err)
+	}
+
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	observe := func(status Status, offset time.Duration) {
+		log.Observe(Result{ServiceName: "api", Status: status, CheckedAt: start.Add(offset), Message: "HTTP 503"})
+	}
+
+	observe(StatusHealthy, 0)
+	if _, ok := log.Current("api"); ok {
+		t.Fatal("Expected no incident while healthy")
+	}
+
+	observe(StatusUnhealthy, time.Minute)
+	observe(StatusUnknown, 2*time.Minute) // Doesn't end the incident
+	observe(StatusUnhealthy, 3*time.Minute)
+	current, ok := log.Current("api")
+	if !ok || !current.Started.Equal(start.Add(time.Minute)) {
+		t.Fatalf("Expected an incident started at the first failure, got %+v (%v)", current, ok)
+	}
+
+	observe(StatusHealthy, 5*time.Minute)
+	if _, ok := log.Current("api"); ok {
+		t.Error("Expected the incident to be resolved on recovery")
+	}
+	last, ok := log.Last("api")
+	if !ok || last.Duration(last.Ended) != 4*time.Minute {
+		t.Errorf("Expected a 4m last incident, got %+v (%v)", last, ok)
+	}
+
+	observe(StatusUnhealthy, 10*time.Minute)
+	observe(StatusHealthy, 11*time.Minute)
+
+	incidents, err := LoadIncidents(path)
+	if err != nil {
+		t.Fatalf("LoadIncidents failed: %v", err)
+	}
+	if len(incidents) != 2 || incidents[0].Message != "HTTP 503" {
+		t.Fatalf("Expected 2 persisted incidents, got %+v", incidents)
+	}
+
+	// A new log picks up the last incident from the file
+	reloaded := NewIncidentLog()
+	if err := reloaded.Persist(path); err != nil {
+		t.Fatalf("Persist failed: %v", err)
+	}
+	if last, ok := reloaded.Last("api"); !ok || last.Duration(last.Ended) != time.Minute {
+		t.Errorf("Expected the reloaded last incident to be the 1m one, got %+v (%v)", last, ok)
+	}
+
+	summaries := SummarizeIncidents(incidents)
+	if len(summaries) != 1 {
+		t.Fatalf("Expected 1 summary, got %d", len(summaries))
+	}
+	s := summaries[0]
+	if s.Count != 2 || s.Longest != 4*time.Minute || s.MTTR != 150*time.Second {
+		t.Errorf("Expected 2 incidents, longest 4m, MTTR 2m30s; got %d, %s, %s", s.Count, s.Longest, s.MTTR)
+	}
+}
+
+func TestLoadIncidentsMissingFile(t *testing.T) {
+	incidents, err := LoadIncidents(filepath.Join(t.TempDir(), "missing.jsonl"))
+	if err != nil || len(incidents) != 0 {
+		t.Errorf("Expected no incidents and no error, got %v, %v", incidents, err)
+	}
+}
@@ -0,0 +1,86 @@
+package monitor
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// inlineSchemaURL locates schemas given inline, which have no file of their own
+const inlineSchemaURL = "scout://inline/schema.json"
+
+// jsonSchema is a compiled JSON Schema. Schemas follow draft 2020-12 unless
+// they declare another draft with $schema, and formats are asserted.
+type jsonSchema struct {
+	schema *jsonschema.Schema
+}
+
+// loadSchema reads a schema given inline as JSON or as a path to a file,
+// returning it with the location its relative $refs resolve against
+func loadSchema(spec string) ([]byte, string, error) {
+	spec = strings.TrimSpace(spec)
+	if strings.HasPrefix(spec, "{") {
+		return []byte(spec), inlineSchemaURL, nil
+	}
+
+	data, err := os.ReadFile(spec)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read JSON schema: %w", err)
+	}
+	return data, spec, nil
+}
+
+// compileSchema parses and compiles a schema, rejecting invalid schemas up
+// front. location names the schema for resolving relative $refs.
+func compileSchema(data []byte, location string) (*jsonSchema, error) {
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON schema: %w", err)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	compiler.AssertFormat()
+	if err := compiler.AddResource(location, doc); err != nil {
+		return nil, fmt.Errorf("invalid JSON schema: %w", err)
+	}
+	schema, err := compiler.Compile(location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON schema: %w", err)
+	}
+	return &jsonSchema{schema: schema}, nil
+}
+
+// Validate checks a JSON document, describing every violation found
+func (s *jsonSchema) Validate(body []byte) error {
+	value, err := jsonschema.UnmarshalJSON(bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("response is not valid JSON: %w", err)
+	}
+
+	err = s.schema.Validate(value)
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return err
+	}
+
+	var violations []string
+	collectViolations(validationErr, &violations)
+	return errors.New(strings.Join(violations, "; "))
+}
+
+// collectViolations gathers the leaf errors of a validation error, each
+// naming the failing location, e.g. "at '/checks/0/latency': got number,
+// want integer"
+func collectViolations(err *jsonschema.ValidationError, violations *[]string) {
+	if len(err.Causes) == 0 {
+		*violations = append(*violations, err.Error())
+		return
+	}
+	for _, cause := range err.Causes {
+		collectViolations(cause, violations)
+	}
+}
@@ -0,0 +1,147 @@
+package monitor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/juststeveking/scout/internal/config"
+)
+
+const testSchema = `{
+	"type": "object",
+	"required": ["status", "checks"],
+	"properties": {
+		"status": {"enum": ["ok", "degraded"]},
+		"version": {"type": "string", "pattern": "^v\\d+"},
+		"checks": {
+			"type": "array",
+			"minItems": 1,
+			"items": {"$ref": "#/definitions/check"}
+		}
+	},
+	"definitions": {
+		"check": {
+			"type": "object",
+			"required": ["name", "latency"],
+			"additionalProperties": false,
+			"properties": {
+				"name": {"type": "string", "minLength": 1},
+				"latency": {"type": "integer", "minimum": 0}
+			}
+		}
+	}
+}`
+
+func TestJSONSchemaValidate(t *testing.T) {
+	schema, err := compileSchema([]byte(testSchema), inlineSchemaURL)
+	if err != nil {
+		t.Fatalf("compileSchema failed: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		body string
+		err  string
+	}{
+		{"valid", `{"status":"ok","version":"v2","checks":[{"name":"db","latency":3}]}`, ""},
+		{"missing required", `{"status":"ok"}`, "missing property 'checks'"},
+		{"enum", `{"status":"down","checks":[{"name":"db","latency":3}]}`, "at '/status': value must be one of"},
+		{"pattern", `{"status":"ok","version":"2","checks":[{"name":"db","latency":3}]}`, "at '/version': '2' does not match pattern"},
+		{"min items", `{"status":"ok","checks":[]}`, "at '/checks': minItems"},
+		{"ref type", `{"status":"ok","checks":[{"name":"db","latency":1.5}]}`, "at '/checks/0/latency': got number, want integer"},
+		{"additional", `{"status":"ok","checks":[{"name":"db","latency":1,"extra":true}]}`, "additional properties 'extra' not allowed"},
+		{"not json", `<html>`, "response is not valid JSON"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := schema.Validate([]byte(tt.body))
+			if tt.err == "" {
+				if err != nil {
+					t.Errorf("Expected valid, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("Expected error containing %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestCompileSchemaInvalidPattern(t *testing.T) {
+	if _, err := compileSchema([]byte(`{"pattern": "("}`), inlineSchemaURL); err == nil {
+		t.Error("Expected error for invalid pattern")
+	}
+}
+
+func TestJSONSchemaKeywords(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		body   string
+		err    string
+	}{
+		{"format", `{"properties": {"email": {"format": "email"}}}`, `{"email": "nope"}`, "not valid email"},
+		{"unique items", `{"uniqueItems": true}`, `[1, 1]`, "items at 0 and 1 are equal"},
+		{"min properties", `{"minProperties": 1}`, `{}`, "minProperties"},
+		{"pattern properties", `{"patternProperties": {"^x-": {"type": "string"}}}`, `{"x-id": 1}`, "want string"},
+		{"prefix items", `{"prefixItems": [{"type": "string"}]}`, `[1]`, "want string"},
+		{"if then", `{"if": {"required": ["error"]}, "then": {"required": ["code"]}}`, `{"error": "boom"}`, "missing property 'code'"},
+		{"defs", `{"$defs": {"id": {"type": "integer"}}, "properties": {"id": {"$ref": "#/$defs/id"}}}`, `{"id": "1"}`, "want integer"},
+		{"self reference", `{"$ref": "#"}`, `{}`, "reference cycle"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema, err := compileSchema([]byte(tt.schema), inlineSchemaURL)
+			if err != nil {
+				t.Fatalf("compileSchema failed: %v", err)
+			}
+			err = schema.Validate([]byte(tt.body))
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("Expected error containing %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestHTTPCheckerWithJSONSchema(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"ok","checks":[{"name":"db","latency":"fast"}]}`))
+	}))
+	defer ts.Close()
+
+	schemaPath := filepath.Join(t.TempDir(), "health.json")
+	if err := os.WriteFile(schemaPath, []byte(testSchema), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	checker := NewHTTPChecker(1 * time.Second)
+	defer checker.Close()
+
+	svc := config.Service{Name: "test-schema", URL: ts.URL, JSONSchema: schemaPath}
+	result := checker.Check(context.Background(), svc)
+	if result.Status != StatusUnhealthy {
+		t.Fatalf("Expected status unhealthy, got %v", result.Status)
+	}
+	if result.Error == nil || !strings.Contains(result.Error.Error(), "/checks/0/latency") {
+		t.Errorf("Expected error naming the failing path, got %v", result.Error)
+	}
+
+	// Inline schemas work too, and compiled schemas are cached
+	svc.JSONSchema = `{"type": "object", "required": ["status"]}`
+	if result := checker.Check(context.Background(), svc); result.Status != StatusHealthy {
+		t.Errorf("Expected status healthy with inline schema, got %v: %v", result.Status, result.Error)
+	}
+	if len(checker.schemas) != 2 {
+		t.Errorf("Expected 2 cached schemas, got %d", len(checker.schemas))
+	}
+}
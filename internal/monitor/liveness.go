@@ -0,0 +1,51 @@
+package monitor
+
+import (
+	"sync"
+	"time"
+)
+
+// liveness records the monitor loop's progress so Scout itself can be
+// health-checked, e.g. by a Kubernetes probe
+type liveness struct {
+	mu         sync.Mutex
+	interval   time.Duration // The loop's check interval, set by Start
+	lastTick   time.Time     // When the loop last started a round
+	lastResult time.Time     // When a check last completed
+}
+
+// markTick records the loop starting a round of checks
+func (l *liveness) markTick(interval time.Duration, now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.interval = interval
+	l.lastTick = now
+}
+
+// markResult records a check completing
+func (l *liveness) markResult(now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lastResult = now
+}
+
+// recent reports whether t is within stallMultiplier intervals of now
+func (l *liveness) recent(t, now time.Time) bool {
+	return !t.IsZero() && now.Sub(t) <= stallMultiplier*l.interval
+}
+
+// Alive reports whether the monitor loop is running, having started a round
+// of checks within the last few check intervals
+func (m *Monitor) Alive(now time.Time) bool {
+	m.liveness.mu.Lock()
+	defer m.liveness.mu.Unlock()
+	return m.liveness.recent(m.liveness.lastTick, now)
+}
+
+// Ready reports whether the monitor is alive and has completed a check
+// within the last few check intervals
+func (m *Monitor) Ready(now time.Time) bool {
+	m.liveness.mu.Lock()
+	defer m.liveness.mu.Unlock()
+	return m.liveness.recent(m.liveness.lastTick, now) && m.liveness.recent(m.liveness.lastResult, now)
+}
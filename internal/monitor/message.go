@@ -0,0 +1,88 @@
+package monitor
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/juststeveking/scout/internal/config"
+)
+
+// messageTemplates holds a service's parsed healthy_message and
+// unhealthy_message templates; either is nil when not configured
+type messageTemplates struct {
+	healthy   *template.Template
+	unhealthy *template.Template
+}
+
+// parseMessages parses every service's message templates once, keyed by
+// service name, so results don't re-parse them
+func parseMessages(services []config.Service) (map[string]messageTemplates, error) {
+	messages := make(map[string]messageTemplates)
+	for _, service := range services {
+		healthy, err := parseMessage("healthy_message", service.HealthyMessage)
+		if err != nil {
+			return nil, fmt.Errorf("service '%s': %w", service.Name, err)
+		}
+		unhealthy, err := parseMessage("unhealthy_message", service.UnhealthyMessage)
+		if err != nil {
+			return nil, fmt.Errorf("service '%s': %w", service.Name, err)
+		}
+		if healthy != nil || unhealthy != nil {
+			messages[service.Name] = messageTemplates{healthy: healthy, unhealthy: unhealthy}
+		}
+	}
+	return messages, nil
+}
+
+// parseMessage parses a message template, returning nil when text is empty
+func parseMessage(name, text string) (*template.Template, error) {
+	if text == "" {
+		return nil, nil
+	}
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s template: %w", name, err)
+	}
+	return tmpl, nil
+}
+
+// messagesFor returns a service's parsed message templates
+func (m *Monitor) messagesFor(serviceName string) messageTemplates {
+	m.muConfigLock.RLock()
+	defer m.muConfigLock.RUnlock()
+	return m.messages[serviceName]
+}
+
+// applyMessage replaces a result's checker message with the service's
+// healthy_message or unhealthy_message template, when configured. Templates
+// see the Result fields, e.g. {{.StatusCode}} and {{.ResponseTime}}.
+func applyMessage(templates messageTemplates, result *Result) {
+	var tmpl *template.Template
+	switch result.Status {
+	case StatusHealthy:
+		tmpl = templates.healthy
+	case StatusUnhealthy:
+		tmpl = templates.unhealthy
+	}
+	if tmpl == nil {
+		return
+	}
+
+	message, err := renderMessage(tmpl, *result)
+	if err != nil {
+		// Keep the checker's message so the card still explains the result
+		result.Message = fmt.Sprintf("%s (%v)", result.Message, err)
+		return
+	}
+	result.Message = message
+}
+
+// renderMessage executes a message template against a result
+func renderMessage(tmpl *template.Template, result Result) (string, error) {
+	var b strings.Builder
+	if err := tmpl.Execute(&b, result); err != nil {
+		return "", fmt.Errorf("failed to render %s template: %w", tmpl.Name(), err)
+	}
+	return b.String(), nil
+}
@@ -2,8 +2,14 @@ package monitor
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"sort"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/juststeveking/scout/internal/config"
@@ -13,6 +19,7 @@ import (
 // Monitor orchestrates health checks for all services
 type Monitor struct {
 	Config          *config.Config
+	muConfigLock    sync.RWMutex
 	checkers        map[string]Checker
 	results         chan Result
 	done            chan struct{}
@@ -21,6 +28,37 @@ type Monitor struct {
 	muStatusLock    sync.RWMutex
 	pausedServices  map[string]bool
 	muPausedLock    sync.RWMutex
+	history         *History
+	captures        map[string]*Capture
+	muCaptureLock   sync.RWMutex
+	transitions     *TransitionLog
+	incidents       *IncidentLog
+	snoozes         *snoozeLog
+	messages        map[string]messageTemplates // Parsed message templates by service, guarded by muConfigLock
+
+	subscribers       map[chan Result]struct{}
+	muSubscribersLock sync.RWMutex
+
+	coalescer *coalescer // Set when coalesce_results is enabled
+
+	limiter *rateLimiter // Enforces max_checks_per_minute
+
+	inFlight       map[string]*checkRun // Running checks, at most one per service
+	lastReported   map[string]time.Time // When each service last finished a check
+	muWatchdogLock sync.Mutex
+
+	liveness liveness // Progress of the Start loop, for Alive and Ready
+
+	replaying atomic.Bool // Set by Replay, which feeds recorded results instead of checking
+
+	// Off-cycle checks run outside checkAll, e.g. from CheckService. They're
+	// bound to runCtx, cancelled when Start stops, and tracked so results
+	// isn't closed while one is still sending.
+	runCtx         context.Context
+	cancelRun      context.CancelFunc
+	offCycle       sync.WaitGroup
+	offCycleClosed bool // Set once Start has stopped accepting off-cycle checks
+	muOffCycleLock sync.Mutex
 }
 
 // NewMonitor creates a new monitor instance
@@ -31,27 +69,132 @@ func NewMonitor(cfg *config.Config) (*Monitor, error) {
 	}
 
 	checkers := map[string]Checker{
-		"http":    NewHTTPChecker(timeout),
-		"tcp":     NewTCPChecker(timeout),
-		"tls":     NewTLSChecker(timeout),
-		"dns":     NewDNSChecker(timeout),
-		"latency": NewLatencyChecker(timeout),
+		"http":      NewHTTPChecker(timeout),
+		"reachable": NewReachableChecker(timeout),
+		"tcp":       NewTCPChecker(timeout),
+		"tls":       NewTLSChecker(timeout),
+		"dns":       NewDNSChecker(timeout),
+		"latency":   NewLatencyChecker(timeout),
+		"download":  NewDownloadChecker(timeout),
+		"command":   NewCommandChecker(timeout, cfg.AllowExec),
+	}
+
+	// Trust a corporate root CA for every check that verifies certificates
+	if cfg.CAFile != "" {
+		pool, err := loadCAFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ca_file: %w", err)
+		}
+		applyRootCAs(checkers, pool)
+	}
+
+	if _, err := parseJitter(cfg.Jitter); err != nil {
+		return nil, err
+	}
+	messages, err := parseMessages(cfg.Services)
+	if err != nil {
+		return nil, err
+	}
+
+	// Connect within dial_timeout while timeout still bounds the whole request
+	if cfg.DialTimeout != "" {
+		dialTimeout, err := time.ParseDuration(cfg.DialTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dial_timeout duration: %w", err)
+		}
+		if dialTimeout > 0 {
+			applyDialTimeout(checkers, dialTimeout)
+		}
 	}
 
+	notifier, err := NewNotifier(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Coalesced results are handed over one at a time so the consumer
+	// always receives the freshest pending result
+	results := make(chan Result, len(cfg.Services)*2)
+	var pending *coalescer
+	if cfg.CoalesceResults {
+		results = make(chan Result)
+		pending = newCoalescer()
+	}
+
+	runCtx, cancelRun := context.WithCancel(context.Background())
+
 	return &Monitor{
 		Config:          cfg,
 		checkers:        checkers,
-		results:         make(chan Result, len(cfg.Services)*2),
+		results:         results,
 		done:            make(chan struct{}),
-		notifier:        notify.NewNotifier(true),
+		notifier:        notifier,
 		serviceStatuses: make(map[string]Status),
 		pausedServices:  make(map[string]bool),
+		history:         NewHistory(DefaultHistorySize),
+		captures:        make(map[string]*Capture),
+		transitions:     NewTransitionLog(DefaultTransitionLogSize),
+		incidents:       NewIncidentLog(),
+		snoozes:         newSnoozeLog(),
+		messages:        messages,
+		subscribers:     make(map[chan Result]struct{}),
+		coalescer:       pending,
+		inFlight:        make(map[string]*checkRun),
+		lastReported:    make(map[string]time.Time),
+		limiter:         newRateLimiter(),
+		runCtx:          runCtx,
+		cancelRun:       cancelRun,
 	}, nil
 }
 
+// NewNotifier builds the notifier described by the notifications config,
+// validating its templates, urgencies and digest interval
+func NewNotifier(cfg *config.Config) (*notify.Notifier, error) {
+	failureUrgency, err := notify.ParseUrgency(cfg.Notifications.FailureUrgency)
+	if err != nil {
+		return nil, fmt.Errorf("invalid notifications config: failure_urgency: %w", err)
+	}
+	recoveryUrgency, err := notify.ParseUrgency(cfg.Notifications.RecoveryUrgency)
+	if err != nil {
+		return nil, fmt.Errorf("invalid notifications config: recovery_urgency: %w", err)
+	}
+
+	notifier, err := notify.NewNotifier(cfg.Notifications.IsEnabled(), notify.Templates{
+		FailureTitle:    cfg.Notifications.FailureTitle,
+		FailureMessage:  cfg.Notifications.FailureMessage,
+		RecoveryTitle:   cfg.Notifications.RecoveryTitle,
+		RecoveryMessage: cfg.Notifications.RecoveryMessage,
+	}, notify.Alerting{
+		FailureUrgency:  failureUrgency,
+		RecoveryUrgency: recoveryUrgency,
+		FailureSound:    cfg.Notifications.FailureSound,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid notifications config: %w", err)
+	}
+	if cfg.Notifications.DigestInterval != "" {
+		interval, err := time.ParseDuration(cfg.Notifications.DigestInterval)
+		if err != nil {
+			return nil, fmt.Errorf("invalid notifications config: digest_interval: %w", err)
+		}
+		if interval <= 0 {
+			return nil, fmt.Errorf("invalid notifications config: digest_interval must be positive, got %s", cfg.Notifications.DigestInterval)
+		}
+		notifier.EnableDigest(interval, cfg.Notifications.DigestOnly)
+	}
+
+	return notifier, nil
+}
+
 // Start begins monitoring all services
 func (m *Monitor) Start(ctx context.Context) {
+	watchdogStopped := make(chan struct{})
 	defer func() {
+		<-watchdogStopped
+		m.stopOffCycle()
+		if m.coalescer != nil {
+			m.coalescer.stop()
+		}
 		close(m.results)
 		close(m.done)
 		m.closeCheckers()
@@ -64,14 +207,42 @@ func (m *Monitor) Start(ctx context.Context) {
 
 	// Initialize service statuses so first failure triggers a notification
 	m.muStatusLock.Lock()
-	for _, service := range m.Config.Services {
+	for _, service := range m.Services() {
 		m.serviceStatuses[service.Name] = StatusUnknown
 	}
 	m.muStatusLock.Unlock()
 
+	// Flag services whose checks hang despite their timeouts
+	go func() {
+		defer close(watchdogStopped)
+		m.watch(ctx, checkInterval)
+	}()
+
+	// Summarize status changes when a digest is configured
+	go m.notifier.RunDigest(ctx)
+
 	// Initial check
+	m.liveness.markTick(checkInterval, time.Now())
 	m.checkAll(ctx)
 
+	m.muConfigLock.RLock()
+	alignToClock := m.Config.AlignToClock
+	m.muConfigLock.RUnlock()
+
+	// Run on wall-clock boundaries, recomputing the wait each round so slow
+	// rounds don't drift
+	if alignToClock {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(nextAlignedDelay(time.Now(), checkInterval)):
+				m.liveness.markTick(checkInterval, time.Now())
+				m.checkAll(ctx)
+			}
+		}
+	}
+
 	// Start periodic checks
 	ticker := time.NewTicker(checkInterval)
 	defer ticker.Stop()
@@ -81,6 +252,7 @@ func (m *Monitor) Start(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
+			m.liveness.markTick(checkInterval, time.Now())
 			m.checkAll(ctx)
 		}
 	}
@@ -89,8 +261,14 @@ func (m *Monitor) Start(ctx context.Context) {
 // checkAll performs health checks on all services concurrently
 func (m *Monitor) checkAll(ctx context.Context) {
 	var wg sync.WaitGroup
+	jitter := m.jitter()
+
+	for _, service := range m.Services() {
+		// Skip services disabled in config
+		if !service.IsEnabled() {
+			continue
+		}
 
-	for _, service := range m.Config.Services {
 		// Skip paused services
 		m.muPausedLock.RLock()
 		isPaused := m.pausedServices[service.Name]
@@ -103,78 +281,421 @@ func (m *Monitor) checkAll(ctx context.Context) {
 		wg.Add(1)
 		go func(svc config.Service) {
 			defer wg.Done()
-			m.checkService(ctx, svc)
+
+			// Spread checks out to avoid synchronized load
+			if jitter > 0 {
+				select {
+				case <-time.After(rand.N(jitter)):
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			// Wait for the check unless the watchdog abandons it, so a hung
+			// check can't hold up every later round
+			run, ok := m.beginCheck(svc.Name)
+			if !ok {
+				return
+			}
+			go m.runCheck(ctx, svc, run)
+			select {
+			case <-run.done:
+			case <-run.abandoned:
+			}
 		}(service)
 	}
 
 	wg.Wait()
 }
 
-// AddService adds a new service to the monitor and triggers an immediate check
-func (m *Monitor) AddService(ctx context.Context, service config.Service) {
-	// The service should already be added to the config object referenced by m.Config
-	// We just need to trigger an immediate check
-	go m.checkService(ctx, service)
+// Services returns a snapshot of the configured services
+func (m *Monitor) Services() []config.Service {
+	m.muConfigLock.RLock()
+	defer m.muConfigLock.RUnlock()
+	services := make([]config.Service, len(m.Config.Services))
+	copy(services, m.Config.Services)
+	return services
+}
+
+// ServiceConfig returns the configuration for a service by name
+func (m *Monitor) ServiceConfig(name string) (config.Service, bool) {
+	m.muConfigLock.RLock()
+	defer m.muConfigLock.RUnlock()
+	for _, service := range m.Config.Services {
+		if service.Name == name {
+			return service, true
+		}
+	}
+	return config.Service{}, false
+}
+
+// AddService adds a new service to the config and triggers an immediate check
+func (m *Monitor) AddService(service config.Service) error {
+	if m.replaying.Load() {
+		return errReplaying
+	}
+
+	m.muConfigLock.Lock()
+	err := m.Config.AddService(service)
+	m.muConfigLock.Unlock()
+	if err != nil {
+		return err
+	}
+
+	m.goCheck(service)
+	return nil
+}
+
+// CheckService runs an immediate off-cycle check of a single service by name
+func (m *Monitor) CheckService(name string) error {
+	if m.replaying.Load() {
+		return errReplaying
+	}
+
+	service, ok := m.ServiceConfig(name)
+	if !ok {
+		return fmt.Errorf("service '%s' not found", name)
+	}
+
+	if !m.goCheck(service) {
+		return errStopped
+	}
+	return nil
+}
+
+// errStopped is returned when asking a stopped monitor for a check
+var errStopped = errors.New("monitor has stopped")
+
+// goCheck starts an off-cycle check of a service, bound to the monitor's
+// run rather than the caller's context. It reports false once the monitor
+// has stopped.
+func (m *Monitor) goCheck(service config.Service) bool {
+	m.muOffCycleLock.Lock()
+	defer m.muOffCycleLock.Unlock()
+
+	if m.offCycleClosed {
+		return false
+	}
+	m.offCycle.Add(1)
+	go func() {
+		defer m.offCycle.Done()
+		m.checkService(m.runCtx, service)
+	}()
+	return true
+}
+
+// stopOffCycle cancels running off-cycle checks, refuses new ones, and waits
+// for them to return so none sends on a closed results channel
+func (m *Monitor) stopOffCycle() {
+	m.muOffCycleLock.Lock()
+	m.offCycleClosed = true
+	m.muOffCycleLock.Unlock()
+
+	m.cancelRun()
+	m.offCycle.Wait()
 }
 
-// checkService performs a health check on a single service
+// SaveConfig writes the monitor's current config to disk
+func (m *Monitor) SaveConfig() error {
+	if m.replaying.Load() {
+		return errReplaying
+	}
+
+	m.muConfigLock.RLock()
+	defer m.muConfigLock.RUnlock()
+	return config.SaveConfig(m.Config)
+}
+
+// ReloadSummary describes the services and settings changed by a config reload
+type ReloadSummary struct {
+	Added   []string
+	Removed []string
+	Updated []string
+
+	// Global settings, by YAML key, that were applied, and those that only
+	// take effect after a restart
+	Settings        []string
+	RestartRequired []string
+}
+
+// String returns a short human-readable description of the reload
+func (r ReloadSummary) String() string {
+	if len(r.Added)+len(r.Removed)+len(r.Updated)+len(r.Settings)+len(r.RestartRequired) == 0 {
+		return "no changes"
+	}
+
+	var parts []string
+	if len(r.Added) > 0 {
+		parts = append(parts, fmt.Sprintf("%d added", len(r.Added)))
+	}
+	if len(r.Removed) > 0 {
+		parts = append(parts, fmt.Sprintf("%d removed", len(r.Removed)))
+	}
+	if len(r.Updated) > 0 {
+		parts = append(parts, fmt.Sprintf("%d updated", len(r.Updated)))
+	}
+	if len(r.Settings) > 0 {
+		parts = append(parts, strings.Join(r.Settings, ", ")+" applied")
+	}
+
+	summary := strings.Join(parts, ", ")
+	if len(r.RestartRequired) > 0 {
+		if summary != "" {
+			summary += "; "
+		}
+		summary += "restart to apply " + strings.Join(r.RestartRequired, ", ")
+	}
+	return summary
+}
+
+// reloadSettings compares the global settings of two configs, returning the
+// YAML keys of changed settings the monitor reads as it runs, and of those
+// fixed when it was created or started
+func reloadSettings(old, updated *config.Config) (live, restart []string) {
+	for _, setting := range []struct {
+		key     string
+		changed bool
+		live    bool
+	}{
+		{"check_interval", old.CheckInterval != updated.CheckInterval, false},
+		{"timeout", old.Timeout != updated.Timeout, false},
+		{"dial_timeout", old.DialTimeout != updated.DialTimeout, false},
+		{"retry_attempts", old.RetryAttempts != updated.RetryAttempts, true},
+		{"lenient_status", old.LenientStatus != updated.LenientStatus, true},
+		{"align_to_clock", old.AlignToClock != updated.AlignToClock, false},
+		{"jitter", old.Jitter != updated.Jitter, true},
+		{"coalesce_results", old.CoalesceResults != updated.CoalesceResults, false},
+		{"checking_delay", old.CheckingDelay != updated.CheckingDelay, true},
+		{"ca_file", old.CAFile != updated.CAFile, false},
+		{"max_checks_per_minute", old.MaxChecksPerMinute != updated.MaxChecksPerMinute, true},
+		{"groups", !reflect.DeepEqual(old.Groups, updated.Groups), true},
+		{"notifications", !reflect.DeepEqual(old.Notifications, updated.Notifications), false},
+	} {
+		switch {
+		case !setting.changed:
+		case setting.live:
+			live = append(live, setting.key)
+		default:
+			restart = append(restart, setting.key)
+		}
+	}
+	return live, restart
+}
+
+// Reload replaces the monitored services with those in cfg, immediately
+// checking any that were added or changed. Global settings read as checks
+// run are applied too; the summary lists those that need a restart. An
+// invalid config is rejected without changing anything.
+func (m *Monitor) Reload(cfg *config.Config) (ReloadSummary, error) {
+	var summary ReloadSummary
+	if _, err := parseJitter(cfg.Jitter); err != nil {
+		return summary, err
+	}
+	messages, err := parseMessages(cfg.Services)
+	if err != nil {
+		return summary, err
+	}
+
+	m.muConfigLock.Lock()
+	previous := make(map[string]config.Service, len(m.Config.Services))
+	for _, service := range m.Config.Services {
+		previous[service.Name] = service
+	}
+
+	var toCheck []config.Service
+	for _, service := range cfg.Services {
+		old, exists := previous[service.Name]
+		delete(previous, service.Name)
+
+		switch {
+		case !exists:
+			summary.Added = append(summary.Added, service.Name)
+		case !reflect.DeepEqual(old, service):
+			summary.Updated = append(summary.Updated, service.Name)
+		default:
+			continue
+		}
+		if service.IsEnabled() {
+			toCheck = append(toCheck, service)
+		}
+	}
+
+	for name := range previous {
+		summary.Removed = append(summary.Removed, name)
+	}
+	sort.Strings(summary.Removed)
+
+	summary.Settings, summary.RestartRequired = reloadSettings(m.Config, cfg)
+
+	m.Config.Services = cfg.Services
+	m.messages = messages
+	m.Config.RetryAttempts = cfg.RetryAttempts
+	m.Config.LenientStatus = cfg.LenientStatus
+	m.Config.Jitter = cfg.Jitter
+	m.Config.CheckingDelay = cfg.CheckingDelay
+	m.Config.MaxChecksPerMinute = cfg.MaxChecksPerMinute
+	m.Config.Groups = cfg.Groups
+	m.muConfigLock.Unlock()
+
+	// Forget state for removed services
+	m.muStatusLock.Lock()
+	for _, name := range summary.Removed {
+		delete(m.serviceStatuses, name)
+	}
+	m.muStatusLock.Unlock()
+
+	m.muPausedLock.Lock()
+	for _, name := range summary.Removed {
+		delete(m.pausedServices, name)
+	}
+	m.muPausedLock.Unlock()
+
+	m.muCaptureLock.Lock()
+	for _, name := range summary.Removed {
+		m.history.Remove(name)
+		delete(m.captures, name)
+	}
+	m.muCaptureLock.Unlock()
+
+	for _, service := range toCheck {
+		m.goCheck(service)
+	}
+
+	return summary, nil
+}
+
+// checkService performs a health check on a single service, unless a check
+// of it is already running
 func (m *Monitor) checkService(ctx context.Context, service config.Service) {
-	// Send checking status
-	select {
-	case m.results <- Result{
-		ServiceName: service.Name,
-		Status:      StatusChecking,
-		CheckedAt:   time.Now(),
-	}:
-	case <-ctx.Done():
+	run, ok := m.beginCheck(service.Name)
+	if !ok {
 		return
 	}
+	m.runCheck(ctx, service, run)
+}
 
-	// Determine which checker to use
-	checkerType := service.Type
-	if checkerType == "" {
-		checkerType = "http" // Default to HTTP
+// runCheck performs a registered check, publishing its result unless the
+// watchdog abandoned it in the meantime
+func (m *Monitor) runCheck(ctx context.Context, service config.Service, run *checkRun) {
+	defer m.endCheck(service.Name, run)
+
+	// Skip the check when it would exceed a rate limit, letting the
+	// dashboard show the service as throttled
+	if !m.allowCheck(service) {
+		m.sendResult(ctx, Result{
+			ServiceName: service.Name,
+			Status:      StatusThrottled,
+			CheckedAt:   time.Now(),
+			Message:     "Rate limited",
+		})
+		return
 	}
 
-	checker, exists := m.checkers[checkerType]
-	if !exists {
-		m.results <- Result{
+	// Send checking status, possibly only once the check proves slow
+	stopChecking := m.announceChecking(ctx, service.Name)
+	defer stopChecking()
+	if ctx.Err() != nil {
+		return
+	}
+
+	// Determine which checker to use
+	checker, err := m.checkerFor(service)
+	if err != nil {
+		stopChecking()
+		result := Result{
 			ServiceName: service.Name,
 			Status:      StatusUnknown,
-			Error:       fmt.Errorf("unknown checker type: %s", checkerType),
+			Error:       err,
 			CheckedAt:   time.Now(),
 		}
+		m.publish(result)
+		m.sendResult(ctx, result)
 		return
 	}
 
 	// Perform the check with retry logic
 	var result Result
+	m.muConfigLock.RLock()
 	retries := m.Config.RetryAttempts
+	m.muConfigLock.RUnlock()
+	if service.RetryAttempts != nil {
+		retries = *service.RetryAttempts
+	}
 	if retries < 1 {
 		retries = 1
 	}
 
 	for attempt := 0; attempt < retries; attempt++ {
+		// Retries count against the rate limits too
+		if attempt > 0 && !m.allowCheck(service) {
+			break
+		}
 		result = checker.Check(ctx, service)
 
 		if result.Status == StatusHealthy {
 			break
 		}
 
-		// Wait before retry (except on last attempt)
+		// Wait before retry (except on last attempt), giving up on shutdown
 		if attempt < retries-1 {
-			time.Sleep(time.Second)
+			select {
+			case <-time.After(retryDelay(result)):
+			case <-ctx.Done():
+				return
+			}
 		}
 	}
 
+	stopChecking()
+	applyMessage(m.messagesFor(service.Name), &result)
+
+	// A superseded check's late result would be out of date
+	if run.isAbandoned() {
+		return
+	}
+
+	m.complete(ctx, result)
+}
+
+// complete records a finished check's result, notifying on status changes,
+// and delivers it to Results() and subscribers
+func (m *Monitor) complete(ctx context.Context, result Result) {
 	// Track status change and send notification if needed
 	m.muStatusLock.Lock()
 	previousStatus := m.serviceStatuses[result.ServiceName]
 	m.serviceStatuses[result.ServiceName] = result.Status
 	m.muStatusLock.Unlock()
+	result.PreviousStatus = previousStatus
+
+	// Log transitions, skipping each service's first result
+	if previousStatus != result.Status && m.history.Len(result.ServiceName) > 0 {
+		m.transitions.Add(Transition{
+			ServiceName: result.ServiceName,
+			From:        previousStatus,
+			To:          result.Status,
+			Message:     result.Message,
+			At:          result.CheckedAt,
+		})
+	}
 
-	// Send notification on status change (but not on initial Checking status)
-	if previousStatus != result.Status && result.Status != StatusChecking {
+	m.incidents.Observe(result)
+
+	// Keep only the latest capture rather than one per history entry
+	if result.Capture != nil {
+		m.muCaptureLock.Lock()
+		m.captures[result.ServiceName] = result.Capture
+		m.muCaptureLock.Unlock()
+	}
+	recorded := result
+	recorded.Capture = nil
+	m.history.Add(recorded)
+	result.AvgLatency, _ = m.history.LatencyEMA(result.ServiceName)
+	m.liveness.markResult(time.Now())
+
+	// Send notification on status change (but not on initial Checking status),
+	// unless the service is snoozed
+	_, snoozed := m.SnoozedUntil(result.ServiceName)
+	if previousStatus != result.Status && result.Status != StatusChecking && !snoozed {
 		// Only notify on actual health status changes, not Unknown->Checking
 		if (previousStatus != StatusUnknown && previousStatus != StatusChecking) ||
 			(result.Status == StatusHealthy || result.Status == StatusUnhealthy) {
@@ -192,13 +713,276 @@ func (m *Monitor) checkService(ctx context.Context, service config.Service) {
 	}
 
 	// Send result
+	m.publish(result)
+	m.sendResult(ctx, result)
+}
+
+// sendResult delivers a result to Results(), coalescing per service when
+// enabled, and reports false if ctx was done first
+func (m *Monitor) sendResult(ctx context.Context, result Result) bool {
+	if m.coalescer != nil {
+		m.coalescer.put(ctx, m.results, result)
+		return ctx.Err() == nil
+	}
+
 	select {
 	case m.results <- result:
+		return true
 	case <-ctx.Done():
-		return
+		return false
 	}
 }
 
+// CheckerType returns the type of checker a service is checked with: its
+// type, inferred from the URL when unset, with lenient_status turning http
+// into reachable
+func (m *Monitor) CheckerType(service config.Service) string {
+	checkerType := service.CheckerType()
+
+	m.muConfigLock.RLock()
+	lenient := m.Config.LenientStatus
+	m.muConfigLock.RUnlock()
+	if checkerType == "http" && lenient {
+		return "reachable"
+	}
+	return checkerType
+}
+
+// checkerFor returns the checker for a service's type
+func (m *Monitor) checkerFor(service config.Service) (Checker, error) {
+	checkerType := m.CheckerType(service)
+
+	checker, exists := m.checkers[checkerType]
+	if !exists {
+		return nil, fmt.Errorf("unknown checker type: %s", checkerType)
+	}
+	if len(service.Endpoints) > 0 {
+		return endpointChecker{checker: checker}, nil
+	}
+	return checker, nil
+}
+
+// nextAlignedDelay returns how long until the next multiple of interval,
+// measured from the zero time (so 30s lands on :00 and :30 of each minute)
+func nextAlignedDelay(now time.Time, interval time.Duration) time.Duration {
+	return now.Truncate(interval).Add(interval).Sub(now)
+}
+
+// jitter returns the configured maximum random delay before each check
+func (m *Monitor) jitter() time.Duration {
+	m.muConfigLock.RLock()
+	defer m.muConfigLock.RUnlock()
+
+	// Validated by NewMonitor and Reload
+	jitter, _ := parseJitter(m.Config.Jitter)
+	return jitter
+}
+
+// parseJitter parses a jitter setting, where empty means no jitter
+func parseJitter(raw string) (time.Duration, error) {
+	if raw == "" {
+		return 0, nil
+	}
+	jitter, err := time.ParseDuration(raw)
+	if err != nil {
+		return 0, fmt.Errorf("invalid jitter duration: %w", err)
+	}
+	if jitter < 0 {
+		return 0, fmt.Errorf("invalid jitter duration: %s is negative", raw)
+	}
+	return jitter, nil
+}
+
+// checkingDelay returns how long a check runs before its checking status is
+// sent, so fast checks don't flicker to the spinner and back
+func (m *Monitor) checkingDelay() time.Duration {
+	m.muConfigLock.RLock()
+	defer m.muConfigLock.RUnlock()
+
+	if m.Config.CheckingDelay == "" {
+		return 0
+	}
+	delay, err := time.ParseDuration(m.Config.CheckingDelay)
+	if err != nil || delay < 0 {
+		return 0
+	}
+	return delay
+}
+
+// announceChecking sends a service's checking status, immediately or after
+// checking_delay. The returned stop func cancels a pending announcement,
+// waiting for one already being sent so it can't arrive after the result.
+func (m *Monitor) announceChecking(ctx context.Context, serviceName string) func() {
+	checking := Result{
+		ServiceName: serviceName,
+		Status:      StatusChecking,
+		CheckedAt:   time.Now(),
+	}
+
+	delay := m.checkingDelay()
+	if delay == 0 {
+		m.sendResult(ctx, checking)
+		return func() {}
+	}
+
+	stop := make(chan struct{})
+	sent := make(chan struct{})
+	go func() {
+		defer close(sent)
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+			m.sendResult(ctx, checking)
+		case <-stop:
+		case <-ctx.Done():
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(stop)
+			<-sent
+		})
+	}
+}
+
+// Retry delays between failed attempts
+const (
+	defaultRetryDelay = time.Second
+	maxRetryAfter     = 30 * time.Second
+)
+
+// retryDelay returns how long to wait before retrying a failed check,
+// honoring a server-requested Retry-After up to maxRetryAfter
+func retryDelay(result Result) time.Duration {
+	if result.RetryAfter <= 0 {
+		return defaultRetryDelay
+	}
+	return min(result.RetryAfter, maxRetryAfter)
+}
+
+// CheckOnce performs a single synchronous check of a service without retries,
+// notifications, or publishing to the results channel
+func (m *Monitor) CheckOnce(ctx context.Context, service config.Service) Result {
+	checker, err := m.checkerFor(service)
+	if err != nil {
+		return Result{
+			ServiceName: service.Name,
+			Status:      StatusUnknown,
+			Error:       err,
+			CheckedAt:   time.Now(),
+		}
+	}
+	return checker.Check(ctx, service)
+}
+
+// CheckAllOnce checks every enabled service once, concurrently and without
+// retries or notifications, returning results in config order
+func (m *Monitor) CheckAllOnce(ctx context.Context) []Result {
+	var services []config.Service
+	for _, service := range m.Services() {
+		if service.IsEnabled() {
+			services = append(services, service)
+		}
+	}
+
+	results := make([]Result, len(services))
+	var wg sync.WaitGroup
+	for i, service := range services {
+		wg.Add(1)
+		go func(i int, svc config.Service) {
+			defer wg.Done()
+			results[i] = m.CheckOnce(ctx, svc)
+		}(i, service)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// RunOnce creates a monitor for cfg, checks every enabled service once, and
+// releases the monitor's resources
+func RunOnce(ctx context.Context, cfg *config.Config) ([]Result, error) {
+	m, err := NewMonitor(cfg)
+	if err != nil {
+		return nil, err
+	}
+	defer m.Close()
+
+	return m.CheckAllOnce(ctx), nil
+}
+
+// Close releases checker resources when the monitor is used without Start
+func (m *Monitor) Close() {
+	m.closeCheckers()
+}
+
+// History returns the recent completed results for a service, oldest first
+func (m *Monitor) History(serviceName string) []Result {
+	return m.history.Results(serviceName)
+}
+
+// Availability returns the percentage of healthy checks across all services
+// over the retained history, and false before any check has completed
+func (m *Monitor) Availability() (float64, bool) {
+	return m.history.Availability()
+}
+
+// CheckRate returns the approximate number of requests made per minute by
+// enabled, unpaused services (counting each sub-check endpoint), and the
+// total number of endpoints monitored
+func (m *Monitor) CheckRate() (perMinute float64, endpoints int) {
+	m.muConfigLock.RLock()
+	interval, err := time.ParseDuration(m.Config.CheckInterval)
+	m.muConfigLock.RUnlock()
+	if err != nil || interval <= 0 {
+		interval = 30 * time.Second
+	}
+
+	checks := 0
+	for _, service := range m.Services() {
+		endpoints += len(service.Endpoints)
+		if !service.IsEnabled() || m.IsPaused(service.Name) {
+			continue
+		}
+		checks += max(len(service.Endpoints), 1)
+	}
+
+	return float64(checks) * float64(time.Minute) / float64(interval), endpoints
+}
+
+// CurrentIncident returns a service's ongoing incident, if it's down
+func (m *Monitor) CurrentIncident(serviceName string) (Incident, bool) {
+	return m.incidents.Current(serviceName)
+}
+
+// LastIncident returns a service's most recently resolved incident
+func (m *Monitor) LastIncident(serviceName string) (Incident, bool) {
+	return m.incidents.Last(serviceName)
+}
+
+// PersistIncidents records resolved incidents to a JSON lines file at path,
+// where `scout incidents` reads them
+func (m *Monitor) PersistIncidents(path string) error {
+	return m.incidents.Persist(path)
+}
+
+// Transitions returns recent status transitions across all services, newest first
+func (m *Monitor) Transitions() []Transition {
+	return m.transitions.Recent()
+}
+
+// LastCapture returns the most recent captured response for a service
+func (m *Monitor) LastCapture(serviceName string) (*Capture, bool) {
+	m.muCaptureLock.RLock()
+	defer m.muCaptureLock.RUnlock()
+	capture, ok := m.captures[serviceName]
+	return capture, ok
+}
+
 // Results returns the channel for receiving check results
 func (m *Monitor) Results() <-chan Result {
 	return m.results
@@ -230,6 +1014,24 @@ func (m *Monitor) IsPaused(serviceName string) bool {
 	return m.pausedServices[serviceName]
 }
 
+// UpdateDisplay changes the dashboard display preferences, which are
+// written out with the rest of the config by SaveConfig
+func (m *Monitor) UpdateDisplay(update func(*config.Display)) {
+	m.muConfigLock.Lock()
+	defer m.muConfigLock.Unlock()
+	update(&m.Config.Display)
+}
+
+// SetNotificationsEnabled mutes or unmutes desktop notifications
+func (m *Monitor) SetNotificationsEnabled(enabled bool) {
+	m.notifier.SetEnabled(enabled)
+}
+
+// NotificationsEnabled returns whether desktop notifications are enabled
+func (m *Monitor) NotificationsEnabled() bool {
+	return m.notifier.Enabled()
+}
+
 // closeCheckers closes all checker resources
 func (m *Monitor) closeCheckers() {
 	for _, checker := range m.checkers {
@@ -239,5 +1041,8 @@ func (m *Monitor) closeCheckers() {
 		if latencyChecker, ok := checker.(*LatencyChecker); ok {
 			latencyChecker.Close()
 		}
+		if downloadChecker, ok := checker.(*DownloadChecker); ok {
+			downloadChecker.Close()
+		}
 	}
 }
@@ -0,0 +1,738 @@
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/juststeveking/scout/internal/config"
+)
+
+func TestMonitorReload(t *testing.T) {
+	cfg := &config.Config{
+		Timeout: "1s",
+		Services: []config.Service{
+			{Name: "api", URL: "http://api.example.com"},
+			{Name: "db", URL: "db.example.com:5432", Type: "tcp"},
+			{Name: "cache", URL: "cache.example.com:6379", Type: "tcp"},
+		},
+	}
+
+	mon, err := NewMonitor(cfg)
+	if err != nil {
+		t.Fatalf("NewMonitor failed: %v", err)
+	}
+	defer mon.Close()
+
+	mon.PauseService("api")
+
+	// Disabled services are not checked, keeping the test offline
+	disabled := false
+	reloaded := &config.Config{
+		CheckInterval: "10s",
+		Timeout:       "1s",
+		RetryAttempts: 2,
+		Jitter:        "2s",
+		Services: []config.Service{
+			{Name: "db", URL: "db.example.com:5433", Type: "tcp", Enabled: &disabled},
+			{Name: "cache", URL: "cache.example.com:6379", Type: "tcp"},
+			{Name: "queue", URL: "queue.example.com:5672", Type: "tcp", Enabled: &disabled},
+		},
+	}
+
+	summary, err := mon.Reload(reloaded)
+	if err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+
+	if !reflect.DeepEqual(summary.Added, []string{"queue"}) {
+		t.Errorf("Expected queue to be added, got %v", summary.Added)
+	}
+	if !reflect.DeepEqual(summary.Removed, []string{"api"}) {
+		t.Errorf("Expected api to be removed, got %v", summary.Removed)
+	}
+	if !reflect.DeepEqual(summary.Updated, []string{"db"}) {
+		t.Errorf("Expected db to be updated, got %v", summary.Updated)
+	}
+	if summary.String() != "1 added, 1 removed, 1 updated, retry_attempts, jitter applied; restart to apply check_interval" {
+		t.Errorf("Unexpected summary string: %q", summary.String())
+	}
+	if mon.Config.Jitter != "2s" || mon.Config.RetryAttempts != 2 || mon.Config.CheckInterval != "" {
+		t.Errorf("Expected live settings applied and check_interval kept, got jitter %q, retries %d, interval %q", mon.Config.Jitter, mon.Config.RetryAttempts, mon.Config.CheckInterval)
+	}
+
+	if len(mon.Services()) != 3 {
+		t.Errorf("Expected 3 services after reload, got %d", len(mon.Services()))
+	}
+	if _, ok := mon.ServiceConfig("api"); ok {
+		t.Error("Expected api to be removed from config")
+	}
+	if mon.IsPaused("api") {
+		t.Error("Expected paused state to be cleared for removed service")
+	}
+}
+
+func TestMonitorRejectsInvalidJitter(t *testing.T) {
+	for _, jitter := range []string{"soon", "-2s"} {
+		_, err := NewMonitor(&config.Config{Timeout: "1s", Jitter: jitter})
+		if err == nil || !strings.Contains(err.Error(), "invalid jitter duration") {
+			t.Errorf("Expected invalid jitter error for %q, got %v", jitter, err)
+		}
+	}
+
+	mon, err := NewMonitor(&config.Config{Timeout: "1s", Jitter: "1s"})
+	if err != nil {
+		t.Fatalf("NewMonitor failed: %v", err)
+	}
+	defer mon.Close()
+
+	// A reload with invalid jitter leaves the running config alone
+	_, err = mon.Reload(&config.Config{Timeout: "1s", Jitter: "-1s", RetryAttempts: 3})
+	if err == nil || !strings.Contains(err.Error(), "invalid jitter duration") {
+		t.Errorf("Expected reload to reject invalid jitter, got %v", err)
+	}
+	if mon.Config.Jitter != "1s" || mon.Config.RetryAttempts != 0 {
+		t.Errorf("Expected config unchanged after rejected reload, got jitter %q, retries %d", mon.Config.Jitter, mon.Config.RetryAttempts)
+	}
+}
+
+func TestNewEvent(t *testing.T) {
+	checkedAt := time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)
+	event := NewEvent(Result{
+		ServiceName:    "api",
+		Status:         StatusUnhealthy,
+		PreviousStatus: StatusHealthy,
+		ResponseTime:   1500 * time.Microsecond,
+		StatusCode:     503,
+		Error:          errors.New("Expected 200, got 503"),
+		FailureReason:  ReasonStatus,
+		CheckedAt:      checkedAt,
+	})
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	expected := `{"service":"api","status":"unhealthy","previous_status":"healthy","latency_ms":1.5,"status_code":503,"error":"Expected 200, got 503","failure_reason":"status","timestamp":"2025-01-02T15:04:05Z"}`
+	if string(data) != expected {
+		t.Errorf("Unexpected event JSON:\n got: %s\nwant: %s", data, expected)
+	}
+
+	// Fields are always present even when empty
+	data, _ = json.Marshal(NewEvent(Result{ServiceName: "db", Status: StatusHealthy}))
+	if !strings.Contains(string(data), `"previous_status":"unknown"`) || !strings.Contains(string(data), `"error":""`) {
+		t.Errorf("Expected stable schema with empty fields, got %s", data)
+	}
+}
+
+func TestMonitorTransitions(t *testing.T) {
+	var healthy atomic.Bool
+	healthy.Store(true)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	notificationsEnabled := false
+	cfg := &config.Config{
+		Timeout:       "1s",
+		RetryAttempts: 1,
+		Notifications: config.Notifications{Enabled: &notificationsEnabled},
+		Services: []config.Service{
+			{Name: "api", URL: ts.URL},
+		},
+	}
+
+	mon, err := NewMonitor(cfg)
+	if err != nil {
+		t.Fatalf("NewMonitor failed: %v", err)
+	}
+	defer mon.Close()
+
+	// Run a check and wait for its completed result
+	check := func() {
+		t.Helper()
+		if err := mon.CheckService("api"); err != nil {
+			t.Fatal(err)
+		}
+		for result := range mon.Results() {
+			if result.Status != StatusChecking {
+				return
+			}
+		}
+	}
+
+	// Start seeds services as unknown; the first result isn't a transition
+	mon.serviceStatuses["api"] = StatusUnknown
+
+	check()
+	healthy.Store(false)
+	check()
+	check()
+	healthy.Store(true)
+	check()
+
+	transitions := mon.Transitions()
+	if len(transitions) != 2 {
+		t.Fatalf("Expected 2 transitions, got %+v", transitions)
+	}
+	if transitions[0].From != StatusUnhealthy || transitions[0].To != StatusHealthy {
+		t.Errorf("Expected newest transition unhealthy → healthy, got %s → %s", transitions[0].From, transitions[0].To)
+	}
+	if transitions[1].From != StatusHealthy || transitions[1].To != StatusUnhealthy {
+		t.Errorf("Expected oldest transition healthy → unhealthy, got %s → %s", transitions[1].From, transitions[1].To)
+	}
+}
+
+func TestNextAlignedDelay(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 10, 0, time.UTC)
+
+	if delay := nextAlignedDelay(now, 30*time.Second); delay != 20*time.Second {
+		t.Errorf("Expected 20s until :30, got %v", delay)
+	}
+	if delay := nextAlignedDelay(now, time.Minute); delay != 50*time.Second {
+		t.Errorf("Expected 50s until the next minute, got %v", delay)
+	}
+
+	// Exactly on a boundary waits a full interval
+	if delay := nextAlignedDelay(now.Add(20*time.Second), 30*time.Second); delay != 30*time.Second {
+		t.Errorf("Expected a full interval on a boundary, got %v", delay)
+	}
+}
+
+func TestMonitorSubscribe(t *testing.T) {
+	mon, err := NewMonitor(&config.Config{Timeout: "1s"})
+	if err != nil {
+		t.Fatalf("NewMonitor failed: %v", err)
+	}
+	defer mon.Close()
+
+	results, unsubscribe := mon.Subscribe()
+
+	// Publishing never blocks, even when the subscriber isn't reading
+	for i := 0; i < subscriberBuffer*2; i++ {
+		mon.publish(Result{ServiceName: "api", StatusCode: i})
+	}
+	if len(results) != subscriberBuffer {
+		t.Errorf("Expected %d buffered results, got %d", subscriberBuffer, len(results))
+	}
+	if result := <-results; result.StatusCode != 0 {
+		t.Errorf("Expected oldest result first, got status code %d", result.StatusCode)
+	}
+
+	unsubscribe()
+	unsubscribe()
+	for range results {
+	}
+	mon.publish(Result{ServiceName: "api"})
+}
+
+func TestApplyMessage(t *testing.T) {
+	messages, err := parseMessages([]config.Service{
+		{
+			Name:             "orders",
+			HealthyMessage:   "Orders API accepting traffic ({{.StatusCode}} in {{.ResponseTime}})",
+			UnhealthyMessage: "Orders API is down",
+		},
+		{Name: "plain"},
+		{Name: "strict", HealthyMessage: "{{.Missing}}"},
+	})
+	if err != nil {
+		t.Fatalf("parseMessages failed: %v", err)
+	}
+
+	result := Result{Status: StatusHealthy, StatusCode: 200, ResponseTime: 120 * time.Millisecond, Message: "HTTP 200"}
+	applyMessage(messages["orders"], &result)
+	if result.Message != "Orders API accepting traffic (200 in 120ms)" {
+		t.Errorf("Unexpected healthy message: %q", result.Message)
+	}
+
+	result = Result{Status: StatusUnhealthy, Message: "HTTP 503"}
+	applyMessage(messages["orders"], &result)
+	if result.Message != "Orders API is down" {
+		t.Errorf("Unexpected unhealthy message: %q", result.Message)
+	}
+
+	// Unconfigured services keep the checker's message
+	result = Result{Status: StatusHealthy, Message: "HTTP 200"}
+	applyMessage(messages["plain"], &result)
+	if result.Message != "HTTP 200" {
+		t.Errorf("Expected checker message to be kept, got %q", result.Message)
+	}
+
+	// Templates that fail to render fall back to the checker's message
+	result = Result{Status: StatusHealthy, Message: "HTTP 200"}
+	applyMessage(messages["strict"], &result)
+	if !strings.HasPrefix(result.Message, "HTTP 200 (failed to render healthy_message template") {
+		t.Errorf("Expected fallback message, got %q", result.Message)
+	}
+
+	// Invalid templates are rejected up front
+	_, err = NewMonitor(&config.Config{
+		Timeout:  "1s",
+		Services: []config.Service{{Name: "broken", URL: "http://example.com", HealthyMessage: "{{.Nope"}},
+	})
+	if err == nil || !strings.Contains(err.Error(), "service 'broken': invalid healthy_message template") {
+		t.Errorf("Expected invalid template error, got %v", err)
+	}
+}
+
+func TestServiceRetryAttemptsOverride(t *testing.T) {
+	var requests atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	notificationsEnabled := false
+	retries := 1
+	cfg := &config.Config{
+		Timeout:       "1s",
+		RetryAttempts: 3,
+		Notifications: config.Notifications{Enabled: &notificationsEnabled},
+		Services: []config.Service{
+			{Name: "submit", URL: ts.URL, Method: "POST", RetryAttempts: &retries},
+		},
+	}
+
+	mon, err := NewMonitor(cfg)
+	if err != nil {
+		t.Fatalf("NewMonitor failed: %v", err)
+	}
+	defer mon.Close()
+
+	if err := mon.CheckService("submit"); err != nil {
+		t.Fatal(err)
+	}
+	for result := range mon.Results() {
+		if result.Status != StatusChecking {
+			break
+		}
+	}
+
+	if n := requests.Load(); n != 1 {
+		t.Errorf("Expected exactly 1 attempt with retry_attempts: 1, got %d", n)
+	}
+}
+
+func TestOffCycleCheckOutlivingStart(t *testing.T) {
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+	defer close(release)
+
+	notificationsEnabled := false
+	disabled := false
+	cfg := &config.Config{
+		CheckInterval: "30s",
+		Timeout:       "5s",
+		Notifications: config.Notifications{Enabled: &notificationsEnabled},
+		Services:      []config.Service{{Name: "api", URL: ts.URL, Enabled: &disabled}},
+	}
+
+	mon, err := NewMonitor(cfg)
+	if err != nil {
+		t.Fatalf("NewMonitor failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go mon.Start(ctx)
+
+	// Nobody reads results while the off-cycle check hangs; stopping must
+	// cancel and wait for it rather than close results under it
+	if err := mon.CheckService("api"); err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+
+	select {
+	case <-mon.Done():
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected Start to stop despite the pending off-cycle check")
+	}
+
+	if err := mon.CheckService("api"); !errors.Is(err, errStopped) {
+		t.Errorf("Expected errStopped after the monitor stopped, got %v", err)
+	}
+}
+
+func TestCoalescerKeepsLatestPerService(t *testing.T) {
+	c := newCoalescer()
+	c.add(Result{ServiceName: "api", Status: StatusChecking})
+	c.add(Result{ServiceName: "db", Status: StatusChecking})
+	c.add(Result{ServiceName: "api", Status: StatusHealthy})
+	c.add(Result{ServiceName: "db", Status: StatusUnhealthy})
+
+	var got []Result
+	for {
+		result, ok := c.next()
+		if !ok {
+			break
+		}
+		got = append(got, result)
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("Expected 2 coalesced results, got %+v", got)
+	}
+	if got[0].ServiceName != "api" || got[0].Status != StatusHealthy {
+		t.Errorf("Expected api's latest result first, got %+v", got[0])
+	}
+	if got[1].ServiceName != "db" || got[1].Status != StatusUnhealthy {
+		t.Errorf("Expected db's latest result second, got %+v", got[1])
+	}
+}
+
+func TestMonitorCoalesceResults(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	notificationsEnabled := false
+	cfg := &config.Config{
+		Timeout:         "1s",
+		RetryAttempts:   1,
+		CoalesceResults: true,
+		Notifications:   config.Notifications{Enabled: &notificationsEnabled},
+		Services:        []config.Service{{Name: "api", URL: ts.URL}},
+	}
+
+	mon, err := NewMonitor(cfg)
+	if err != nil {
+		t.Fatalf("NewMonitor failed: %v", err)
+	}
+	defer mon.Close()
+
+	// Nobody reads while the check completes; the consumer still ends up
+	// with the completed result
+	if err := mon.CheckService("api"); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.After(2 * time.Second)
+	for {
+		select {
+		case result := <-mon.Results():
+			if result.Status == StatusHealthy {
+				return
+			}
+		case <-deadline:
+			t.Fatal("Timed out waiting for the coalesced result")
+		}
+	}
+}
+
+// hangingChecker blocks every check until released, ignoring its context
+type hangingChecker struct {
+	release chan struct{}
+}
+
+func (h hangingChecker) Check(ctx context.Context, service config.Service) Result {
+	<-h.release
+	return Result{ServiceName: service.Name, Status: StatusHealthy, CheckedAt: time.Now()}
+}
+
+func TestWatchdogAbandonsStalledCheck(t *testing.T) {
+	notificationsEnabled := false
+	cfg := &config.Config{
+		Timeout:       "1s",
+		Notifications: config.Notifications{Enabled: &notificationsEnabled},
+		Services:      []config.Service{{Name: "hung", URL: "http://hung.example.com", Type: "hang"}},
+	}
+
+	mon, err := NewMonitor(cfg)
+	if err != nil {
+		t.Fatalf("NewMonitor failed: %v", err)
+	}
+	defer mon.Close()
+
+	hang := hangingChecker{release: make(chan struct{})}
+	mon.checkers["hang"] = hang
+
+	roundDone := make(chan struct{})
+	go func() {
+		mon.checkAll(context.Background())
+		close(roundDone)
+	}()
+
+	if result := <-mon.Results(); result.Status != StatusChecking {
+		t.Fatalf("Expected checking result, got %v", result.Status)
+	}
+
+	// A second check is skipped while the first is still running
+	mon.checkService(context.Background(), cfg.Services[0])
+
+	select {
+	case <-roundDone:
+		t.Fatal("Expected the round to wait for the running check")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if stalled := mon.abandonStalled(time.Now(), time.Minute); len(stalled) != 0 {
+		t.Fatalf("Expected no stalled checks within the limit, got %+v", stalled)
+	}
+
+	stalled := mon.abandonStalled(time.Now().Add(2*time.Minute), time.Minute)
+	if len(stalled) != 1 || stalled[0].ServiceName != "hung" || stalled[0].Status != StatusStalled {
+		t.Fatalf("Expected one stalled result for 'hung', got %+v", stalled)
+	}
+
+	select {
+	case <-roundDone:
+	case <-time.After(time.Second):
+		t.Fatal("Expected abandoning the check to release the round")
+	}
+
+	// The abandoned check's late result is dropped, and the service can be
+	// checked afresh
+	close(hang.release)
+	mon.checkService(context.Background(), cfg.Services[0])
+
+	var statuses []Status
+	for len(statuses) < 2 {
+		statuses = append(statuses, (<-mon.Results()).Status)
+	}
+	if statuses[0] != StatusChecking || statuses[1] != StatusHealthy {
+		t.Errorf("Expected a fresh checking then healthy result, got %v", statuses)
+	}
+	select {
+	case result := <-mon.Results():
+		t.Errorf("Expected the abandoned check's result to be dropped, got %+v", result)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestCheckingDelay(t *testing.T) {
+	notificationsEnabled := false
+	cfg := &config.Config{
+		Timeout:       "1s",
+		RetryAttempts: 1,
+		CheckingDelay: "100ms",
+		Notifications: config.Notifications{Enabled: &notificationsEnabled},
+		Services:      []config.Service{{Name: "svc", URL: "http://svc.example.com", Type: "hang"}},
+	}
+
+	mon, err := NewMonitor(cfg)
+	if err != nil {
+		t.Fatalf("NewMonitor failed: %v", err)
+	}
+	defer mon.Close()
+
+	// A check finishing within the delay reports only its result
+	fast := hangingChecker{release: make(chan struct{})}
+	close(fast.release)
+	mon.checkers["hang"] = fast
+	mon.checkService(context.Background(), cfg.Services[0])
+	if result := <-mon.Results(); result.Status != StatusHealthy {
+		t.Fatalf("Expected a fast check to skip the checking status, got %v", result.Status)
+	}
+
+	// A slow check reports checking once the delay passes, then its result
+	slow := hangingChecker{release: make(chan struct{})}
+	mon.checkers["hang"] = slow
+	go mon.checkService(context.Background(), cfg.Services[0])
+
+	select {
+	case result := <-mon.Results():
+		if result.Status != StatusChecking {
+			t.Fatalf("Expected checking status for a slow check, got %v", result.Status)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for the delayed checking status")
+	}
+	close(slow.release)
+	if result := <-mon.Results(); result.Status != StatusHealthy {
+		t.Errorf("Expected healthy after the checking status, got %v", result.Status)
+	}
+}
+
+func TestMonitorLiveness(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	notificationsEnabled := false
+	cfg := &config.Config{
+		CheckInterval: "1m",
+		Timeout:       "1s",
+		RetryAttempts: 1,
+		Notifications: config.Notifications{Enabled: &notificationsEnabled},
+		Services:      []config.Service{{Name: "api", URL: ts.URL}},
+	}
+
+	mon, err := NewMonitor(cfg)
+	if err != nil {
+		t.Fatalf("NewMonitor failed: %v", err)
+	}
+
+	if mon.Alive(time.Now()) || mon.Ready(time.Now()) {
+		t.Fatal("Expected a monitor that hasn't started to be neither alive nor ready")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go mon.Start(ctx)
+	defer func() {
+		cancel()
+		<-mon.Done()
+	}()
+
+	for result := range mon.Results() {
+		if result.Status == StatusHealthy {
+			break
+		}
+	}
+
+	now := time.Now()
+	if !mon.Alive(now) || !mon.Ready(now) {
+		t.Errorf("Expected the running monitor to be alive and ready")
+	}
+
+	// A loop that hasn't ticked for several intervals is considered dead
+	later := now.Add(stallMultiplier*time.Minute + time.Second)
+	if mon.Alive(later) || mon.Ready(later) {
+		t.Errorf("Expected a stale monitor to be neither alive nor ready")
+	}
+}
+
+func TestMonitorCheckRate(t *testing.T) {
+	disabled := false
+	notificationsEnabled := false
+	cfg := &config.Config{
+		CheckInterval: "30s",
+		Timeout:       "1s",
+		RetryAttempts: 1,
+		Notifications: config.Notifications{Enabled: &notificationsEnabled},
+		Services: []config.Service{
+			{Name: "api", URL: "http://localhost"},
+			{Name: "web", URL: "http://localhost", Endpoints: []config.Endpoint{{Path: "/a"}, {Path: "/b"}, {Path: "/c"}}},
+			{Name: "paused", URL: "http://localhost"},
+			{Name: "off", URL: "http://localhost", Enabled: &disabled},
+		},
+	}
+
+	mon, err := NewMonitor(cfg)
+	if err != nil {
+		t.Fatalf("NewMonitor failed: %v", err)
+	}
+	mon.PauseService("paused")
+
+	perMinute, endpoints := mon.CheckRate()
+	if perMinute != 8 {
+		t.Errorf("Expected 8 checks/min, got %g", perMinute)
+	}
+	if endpoints != 3 {
+		t.Errorf("Expected 3 endpoints, got %d", endpoints)
+	}
+}
+
+func TestMonitorReplay(t *testing.T) {
+	start := time.Date(2025, 1, 2, 15, 0, 0, 0, time.UTC)
+	events := []Event{
+		{Service: "api", Status: StatusHealthy, LatencyMs: 12, StatusCode: 200, Timestamp: start},
+		{Service: "api", Status: StatusUnhealthy, StatusCode: 503, Error: "Expected 200, got 503", FailureReason: ReasonStatus, Timestamp: start.Add(time.Second)},
+		{Service: "api", Status: StatusHealthy, LatencyMs: 9, StatusCode: 200, Timestamp: start.Add(2 * time.Second)},
+	}
+
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	var lines []string
+	for _, event := range events {
+		data, _ := json.Marshal(event)
+		lines = append(lines, string(data))
+	}
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := LoadEvents(path)
+	if err != nil {
+		t.Fatalf("LoadEvents failed: %v", err)
+	}
+	if len(results) != 3 || results[1].Error == nil || results[1].FailureReason != ReasonStatus {
+		t.Fatalf("Expected 3 results with the recorded error, got %+v", results)
+	}
+
+	notificationsEnabled := false
+	cfg := &config.Config{
+		CheckInterval: "30s",
+		Timeout:       "1s",
+		RetryAttempts: 1,
+		Notifications: config.Notifications{Enabled: &notificationsEnabled},
+		Services:      []config.Service{{Name: "api"}},
+	}
+	mon, err := NewMonitor(cfg)
+	if err != nil {
+		t.Fatalf("NewMonitor failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	began := time.Now()
+	go mon.Replay(ctx, results, 100)
+
+	for i := range results {
+		result := <-mon.Results()
+		if result.Status != results[i].Status || !result.CheckedAt.Equal(results[i].CheckedAt) {
+			t.Errorf("Result %d: expected %s at %s, got %s at %s", i, results[i].Status, results[i].CheckedAt, result.Status, result.CheckedAt)
+		}
+	}
+	if elapsed := time.Since(began); elapsed < 15*time.Millisecond {
+		t.Errorf("Expected the 2s of recorded gaps to take ~20ms at 100x, took %s", elapsed)
+	}
+
+	if transitions := mon.Transitions(); len(transitions) != 2 {
+		t.Errorf("Expected 2 replayed transitions, got %d", len(transitions))
+	}
+	if err := mon.SaveConfig(); err == nil {
+		t.Error("Expected saving the config to be refused while replaying")
+	}
+
+	cancel()
+	<-mon.Done()
+}
+
+func TestCheckerForInfersType(t *testing.T) {
+	mon, err := NewMonitor(&config.Config{Timeout: "1s"})
+	if err != nil {
+		t.Fatalf("NewMonitor failed: %v", err)
+	}
+
+	tests := []struct {
+		service  config.Service
+		expected Checker
+	}{
+		{config.Service{URL: "https://api.example.com"}, mon.checkers["http"]},
+		{config.Service{URL: "tcp://db.example.com:5432"}, mon.checkers["tcp"]},
+		{config.Service{URL: "db.example.com:5432"}, mon.checkers["tcp"]},
+		{config.Service{URL: "db.example.com:5432", Type: "tls"}, mon.checkers["tls"]},
+		{config.Service{URL: "grpc://orders.internal:50051"}, mon.checkers["http"]},
+	}
+
+	for _, tt := range tests {
+		checker, err := mon.checkerFor(tt.service)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.service.URL, err)
+			continue
+		}
+		if checker != tt.expected {
+			t.Errorf("%s: expected %T, got %T", tt.service.URL, tt.expected, checker)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package monitor
+
+import (
+	"sync"
+	"time"
+
+	"github.com/juststeveking/scout/internal/config"
+)
+
+// tokenBucket allows up to limit checks per minute, refilling continuously
+// and bursting up to a minute's worth
+type tokenBucket struct {
+	limit  int
+	tokens float64
+	last   time.Time
+}
+
+// newTokenBucket returns a full bucket for limit checks per minute
+func newTokenBucket(limit int, now time.Time) *tokenBucket {
+	return &tokenBucket{limit: limit, tokens: float64(limit), last: now}
+}
+
+// available refills the bucket up to now and reports whether a check may run
+func (b *tokenBucket) available(now time.Time) bool {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = min(float64(b.limit), b.tokens+elapsed.Minutes()*float64(b.limit))
+		b.last = now
+	}
+	return b.tokens >= 1
+}
+
+// rateLimiter enforces max_checks_per_minute globally and per service, so a
+// tight interval or repeated manual checks can't flood a fragile upstream
+type rateLimiter struct {
+	mu       sync.Mutex
+	global   *tokenBucket
+	services map[string]*tokenBucket
+}
+
+// newRateLimiter creates an empty rate limiter
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{services: make(map[string]*tokenBucket)}
+}
+
+// allow takes a token from the service's bucket and the global one, or
+// neither when either is empty. A limit of zero is unlimited, and buckets are
+// replaced when their limit changes, e.g. after a config reload.
+func (l *rateLimiter) allow(service config.Service, globalLimit int, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var buckets []*tokenBucket
+	if globalLimit > 0 {
+		if l.global == nil || l.global.limit != globalLimit {
+			l.global = newTokenBucket(globalLimit, now)
+		}
+		buckets = append(buckets, l.global)
+	}
+	if limit := service.MaxChecksPerMinute; limit > 0 {
+		bucket, ok := l.services[service.Name]
+		if !ok || bucket.limit != limit {
+			bucket = newTokenBucket(limit, now)
+			l.services[service.Name] = bucket
+		}
+		buckets = append(buckets, bucket)
+	}
+
+	for _, bucket := range buckets {
+		if !bucket.available(now) {
+			return false
+		}
+	}
+	for _, bucket := range buckets {
+		bucket.tokens--
+	}
+	return true
+}
+
+// allowCheck reports whether a check of service may run now under the
+// configured rate limits, consuming a token if so
+func (m *Monitor) allowCheck(service config.Service) bool {
+	m.muConfigLock.RLock()
+	globalLimit := m.Config.MaxChecksPerMinute
+	m.muConfigLock.RUnlock()
+
+	return m.limiter.allow(service, globalLimit, time.Now())
+}
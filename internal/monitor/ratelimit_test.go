@@ -0,0 +1,104 @@
+package monitor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/juststeveking/scout/internal/config"
+)
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Date(2025, 1, 2, 15, 0, 0, 0, time.UTC)
+	limiter := newRateLimiter()
+	api := config.Service{Name: "api", MaxChecksPerMinute: 2}
+	web := config.Service{Name: "web"}
+
+	// The service bucket allows a minute's worth, then refills continuously
+	for i := 0; i < 2; i++ {
+		if !limiter.allow(api, 0, now) {
+			t.Fatalf("Expected check %d within the service limit to be allowed", i+1)
+		}
+	}
+	if limiter.allow(api, 0, now) {
+		t.Error("Expected a third check in the same minute to be throttled")
+	}
+	if !limiter.allow(api, 0, now.Add(30*time.Second)) {
+		t.Error("Expected a token to refill after half a minute at 2 per minute")
+	}
+	if !limiter.allow(web, 0, now) {
+		t.Error("Expected a service without a limit to be unlimited")
+	}
+
+	// The global limit is shared, and a throttled service doesn't spend it
+	limiter = newRateLimiter()
+	if !limiter.allow(api, 3, now) || !limiter.allow(api, 3, now) {
+		t.Fatal("Expected checks within both limits to be allowed")
+	}
+	if limiter.allow(api, 3, now) {
+		t.Error("Expected the service limit to apply under a higher global limit")
+	}
+	if !limiter.allow(web, 3, now) {
+		t.Error("Expected the global token left by the throttled check to be available")
+	}
+	if limiter.allow(web, 3, now) {
+		t.Error("Expected the global limit to throttle once spent")
+	}
+
+	// Changing a limit starts a fresh bucket
+	api.MaxChecksPerMinute = 5
+	if !limiter.allow(api, 0, now) {
+		t.Error("Expected a changed limit to replace the empty bucket")
+	}
+}
+
+func TestMonitorRateLimitedCheck(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	notificationsEnabled := false
+	cfg := &config.Config{
+		CheckInterval: "30s",
+		Timeout:       "1s",
+		RetryAttempts: 1,
+		Notifications: config.Notifications{Enabled: &notificationsEnabled},
+		Services:      []config.Service{{Name: "api", URL: ts.URL, MaxChecksPerMinute: 1}},
+	}
+	mon, err := NewMonitor(cfg)
+	if err != nil {
+		t.Fatalf("NewMonitor failed: %v", err)
+	}
+	defer mon.Close()
+
+	// Drain between checks, since the results buffer only holds two per service
+	ctx := context.Background()
+	var statuses []Status
+	for i := 0; i < 2; i++ {
+		mon.checkService(ctx, cfg.Services[0])
+		for len(mon.results) > 0 {
+			statuses = append(statuses, (<-mon.results).Status)
+		}
+	}
+
+	want := []Status{StatusChecking, StatusHealthy, StatusThrottled}
+	if len(statuses) != len(want) {
+		t.Fatalf("Expected results %v, got %v", want, statuses)
+	}
+	for i := range want {
+		if statuses[i] != want[i] {
+			t.Fatalf("Expected results %v, got %v", want, statuses)
+		}
+	}
+
+	// The skipped check leaves the last completed status in place
+	mon.muStatusLock.RLock()
+	status := mon.serviceStatuses["api"]
+	mon.muStatusLock.RUnlock()
+	if status != StatusHealthy {
+		t.Errorf("Expected throttling to keep the healthy status, got %v", status)
+	}
+}
@@ -0,0 +1,52 @@
+package monitor
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+)
+
+// FailureReason categorizes why a check failed, for triage at a glance
+type FailureReason string
+
+const (
+	ReasonNone      FailureReason = ""
+	ReasonDNS       FailureReason = "dns"       // The host name didn't resolve
+	ReasonConnect   FailureReason = "connect"   // The connection was refused or dropped
+	ReasonTLS       FailureReason = "tls"       // Handshake or certificate problem
+	ReasonTimeout   FailureReason = "timeout"   // No answer within the timeout
+	ReasonStatus    FailureReason = "status"    // Unexpected status code or exit code
+	ReasonAssertion FailureReason = "assertion" // The response didn't match its expectations
+	ReasonLatency   FailureReason = "latency"   // Slower than the latency threshold or minimum throughput
+)
+
+// classifyError categorizes a connection-level error, returning fallback
+// when it isn't a DNS, timeout, or TLS failure
+func classifyError(err error, fallback FailureReason) FailureReason {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return ReasonDNS
+	}
+
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return ReasonTimeout
+	}
+
+	var (
+		verifyErr    *tls.CertificateVerificationError
+		recordErr    tls.RecordHeaderError
+		alertErr     tls.AlertError
+		authorityErr x509.UnknownAuthorityError
+		hostnameErr  x509.HostnameError
+		invalidErr   x509.CertificateInvalidError
+	)
+	if errors.As(err, &verifyErr) || errors.As(err, &recordErr) || errors.As(err, &alertErr) ||
+		errors.As(err, &authorityErr) || errors.As(err, &hostnameErr) || errors.As(err, &invalidErr) {
+		return ReasonTLS
+	}
+
+	return fallback
+}
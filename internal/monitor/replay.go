@@ -0,0 +1,98 @@
+package monitor
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// errReplaying is returned by operations that need live checks or would
+// save the synthetic config used while replaying
+var errReplaying = errors.New("not available while replaying recorded results")
+
+// LoadEvents reads check results recorded with --events, one JSON event per
+// line, in the order they were checked
+func LoadEvents(path string) ([]Result, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open events file: %w", err)
+	}
+	defer f.Close()
+
+	var results []Result
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		var event Event
+		if err := json.Unmarshal([]byte(text), &event); err != nil {
+			return nil, fmt.Errorf("invalid event on line %d: %w", line, err)
+		}
+		if event.Service == "" {
+			return nil, fmt.Errorf("invalid event on line %d: missing service", line)
+		}
+		results = append(results, event.Result())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read events file: %w", err)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool { return results[i].CheckedAt.Before(results[j].CheckedAt) })
+	return results, nil
+}
+
+// Replay feeds recorded results through the monitor in place of Start,
+// keeping their original timestamps and spacing them out by the recorded
+// gaps divided by speed. History, transitions, and incidents build up as
+// they did live. Like Start, it runs until ctx is cancelled.
+func (m *Monitor) Replay(ctx context.Context, results []Result, speed float64) {
+	m.replaying.Store(true)
+	defer func() {
+		m.stopOffCycle()
+		if m.coalescer != nil {
+			m.coalescer.stop()
+		}
+		close(m.results)
+		close(m.done)
+		m.closeCheckers()
+	}()
+
+	if speed <= 0 {
+		speed = 1
+	}
+
+	m.muStatusLock.Lock()
+	for _, service := range m.Services() {
+		m.serviceStatuses[service.Name] = StatusUnknown
+	}
+	m.muStatusLock.Unlock()
+
+	var previous time.Time
+	for _, result := range results {
+		if gap := result.CheckedAt.Sub(previous); !previous.IsZero() && gap > 0 {
+			select {
+			case <-time.After(time.Duration(float64(gap) / speed)):
+			case <-ctx.Done():
+				return
+			}
+		}
+		previous = result.CheckedAt
+
+		// Paused services drop out of the replay as they would live
+		if m.IsPaused(result.ServiceName) {
+			continue
+		}
+		m.complete(ctx, result)
+	}
+
+	<-ctx.Done()
+}
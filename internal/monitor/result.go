@@ -1,6 +1,10 @@
 package monitor
 
-import "time"
+import (
+	"time"
+
+	"github.com/juststeveking/scout/internal/config"
+)
 
 // Status represents the health status of a service
 type Status string
@@ -10,15 +14,42 @@ const (
 	StatusUnhealthy Status = "unhealthy"
 	StatusUnknown   Status = "unknown"
 	StatusChecking  Status = "checking"
+	StatusPending   Status = "pending"   // Not checked yet, shown until a service's first result arrives
+	StatusThrottled Status = "throttled" // A check was skipped by max_checks_per_minute; the last result still stands
+	StatusStalled   Status = "stalled"   // A check hung past the watchdog's limit
+	StatusDegraded  Status = "degraded"  // A group with fewer healthy members than its quorum
 )
 
 // Result represents the result of a health check
 type Result struct {
-	ServiceName  string
-	Status       Status
-	ResponseTime time.Duration
-	StatusCode   int
-	Error        error
-	CheckedAt    time.Time
-	Message      string
+	ServiceName    string
+	Status         Status
+	PreviousStatus Status
+	ResponseTime   time.Duration
+	AvgLatency     time.Duration // Exponential moving average of the service's response times
+	StatusCode     int
+	Error          error
+	CheckedAt      time.Time
+	Message        string
+	FailureReason  FailureReason // Why an unhealthy check failed, e.g. timeout or assertion
+	RetryAfter     time.Duration // Server-requested delay before retrying (429/503 Retry-After)
+	Capture        *Capture      // Response details, set only for services with capture enabled
+	Details        *Timing       // Connection phase breakdown for HTTP and latency checks
+
+	Endpoints  []EndpointResult  // Per-endpoint outcomes for services with endpoints configured
+	Fields     []Field           // Response values extracted by display_fields
+	Assertions []AssertionResult // Every JSON assertion's outcome, for services with json_assertions
+}
+
+// AssertionResult is the outcome of a single JSON assertion
+type AssertionResult struct {
+	Assertion config.JSONAssertion
+	Passed    bool
+	Error     error // Why the assertion failed
+}
+
+// Field is a value extracted from a response body for display
+type Field struct {
+	Path  string
+	Value string
 }
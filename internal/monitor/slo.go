@@ -0,0 +1,93 @@
+package monitor
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juststeveking/scout/internal/config"
+)
+
+// DefaultSLOPercentile is the latency percentile used when none is configured
+const DefaultSLOPercentile = 95
+
+// SLOReport describes whether a service currently meets its objectives
+type SLOReport struct {
+	Samples int
+
+	HasAvailability    bool
+	Availability       float64 // Measured availability percentage
+	AvailabilityTarget float64
+	AvailabilityMet    bool
+
+	HasLatency    bool
+	Percentile    float64
+	Latency       time.Duration // Measured latency at Percentile
+	LatencyTarget time.Duration
+	LatencyMet    bool
+}
+
+// Met reports whether every configured objective is met
+func (r SLOReport) Met() bool {
+	return (!r.HasAvailability || r.AvailabilityMet) && (!r.HasLatency || r.LatencyMet)
+}
+
+// String returns a compact summary, e.g. "99.92% ✓ / p95 280ms ✓"
+func (r SLOReport) String() string {
+	if r.Samples == 0 {
+		return "no data"
+	}
+
+	var parts []string
+	if r.HasAvailability {
+		parts = append(parts, fmt.Sprintf("%.2f%% %s", r.Availability, sloMark(r.AvailabilityMet)))
+	}
+	if r.HasLatency {
+		parts = append(parts, fmt.Sprintf("p%g %dms %s", r.Percentile, r.Latency.Milliseconds(), sloMark(r.LatencyMet)))
+	}
+	return strings.Join(parts, " / ")
+}
+
+// sloMark returns a check or cross for an objective
+func sloMark(met bool) string {
+	if met {
+		return "✓"
+	}
+	return "✗"
+}
+
+// EvaluateSLO evaluates a service's objectives against its results
+func EvaluateSLO(slo config.SLO, results []Result) SLOReport {
+	report := SLOReport{
+		Samples:            len(results),
+		HasAvailability:    slo.Availability > 0,
+		AvailabilityTarget: slo.Availability,
+		HasLatency:         slo.LatencyTarget > 0,
+		Percentile:         slo.LatencyPercentile,
+		LatencyTarget:      time.Duration(slo.LatencyTarget) * time.Millisecond,
+	}
+	if report.Percentile <= 0 {
+		report.Percentile = DefaultSLOPercentile
+	}
+	if len(results) == 0 {
+		return report
+	}
+
+	healthy := 0
+	latencies := make([]time.Duration, 0, len(results))
+	for _, result := range results {
+		if result.Status == StatusHealthy {
+			healthy++
+		}
+		latencies = append(latencies, result.ResponseTime)
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+	report.Availability = float64(healthy) / float64(len(results)) * 100
+	report.AvailabilityMet = report.Availability >= report.AvailabilityTarget
+	report.Latency = percentile(latencies, report.Percentile)
+	report.LatencyMet = report.Latency <= report.LatencyTarget
+
+	return report
+}
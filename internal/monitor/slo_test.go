@@ -0,0 +1,92 @@
+package monitor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juststeveking/scout/internal/config"
+)
+
+func TestEvaluateSLO(t *testing.T) {
+	var results []Result
+	for i := 1; i <= 1000; i++ {
+		status := StatusHealthy
+		if i == 500 {
+			status = StatusUnhealthy
+		}
+		results = append(results, Result{
+			Status:       status,
+			ResponseTime: time.Duration(i%300) * time.Millisecond,
+		})
+	}
+
+	report := EvaluateSLO(config.SLO{Availability: 99.9, LatencyTarget: 300}, results)
+	if !report.Met() {
+		t.Errorf("Expected SLO to be met, got %s", report)
+	}
+	if report.Availability != 99.9 {
+		t.Errorf("Expected 99.9%% availability, got %.2f", report.Availability)
+	}
+	if report.Percentile != DefaultSLOPercentile {
+		t.Errorf("Expected default percentile %d, got %g", DefaultSLOPercentile, report.Percentile)
+	}
+
+	report = EvaluateSLO(config.SLO{Availability: 99.95, LatencyTarget: 100, LatencyPercentile: 50}, results)
+	if report.AvailabilityMet || report.LatencyMet || report.Met() {
+		t.Errorf("Expected both objectives to be missed, got %s", report)
+	}
+	if report.String() != "99.90% ✗ / p50 133ms ✗" {
+		t.Errorf("Unexpected report string: %q", report.String())
+	}
+
+	if EvaluateSLO(config.SLO{Availability: 99}, nil).String() != "no data" {
+		t.Error("Expected no data for empty history")
+	}
+}
+
+func TestHistory(t *testing.T) {
+	history := NewHistory(3)
+	for i := 0; i < 5; i++ {
+		history.Add(Result{ServiceName: "api", StatusCode: i})
+	}
+
+	results := history.Results("api")
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 results, got %d", len(results))
+	}
+	if results[0].StatusCode != 2 || results[2].StatusCode != 4 {
+		t.Errorf("Expected oldest results to be evicted, got %+v", results)
+	}
+
+	history.Add(Result{ServiceName: "api", Status: StatusHealthy})
+	history.Add(Result{ServiceName: "web", Status: StatusHealthy})
+	if availability, ok := history.Availability(); !ok || availability != 50 {
+		t.Errorf("Expected 50%% availability, got %.2f (%v)", availability, ok)
+	}
+
+	history.Remove("api")
+	if len(history.Results("api")) != 0 {
+		t.Error("Expected history to be cleared")
+	}
+}
+
+func TestHistoryLatencyEMA(t *testing.T) {
+	history := NewHistory(10)
+	if _, ok := history.LatencyEMA("api"); ok {
+		t.Fatal("Expected no average before any response time")
+	}
+
+	history.Add(Result{ServiceName: "api", ResponseTime: 100 * time.Millisecond})
+	history.Add(Result{ServiceName: "api", ResponseTime: 0}) // e.g. a connection failure
+	history.Add(Result{ServiceName: "api", ResponseTime: 200 * time.Millisecond})
+
+	// 100ms + 0.3 × (200ms − 100ms)
+	if ema, ok := history.LatencyEMA("api"); !ok || ema != 130*time.Millisecond {
+		t.Errorf("Expected a 130ms average, got %s (%v)", ema, ok)
+	}
+
+	history.Remove("api")
+	if _, ok := history.LatencyEMA("api"); ok {
+		t.Error("Expected the average to be cleared with the history")
+	}
+}
@@ -0,0 +1,184 @@
+package monitor
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// snoozeRefreshInterval is how often a persisted snooze file is checked for
+// changes made by `scout service:snooze` while monitoring runs
+const snoozeRefreshInterval = time.Second
+
+// snoozeLog tracks when each snoozed service's notifications resume,
+// optionally persisted so a restart doesn't clear an active snooze
+type snoozeLog struct {
+	mu        sync.Mutex
+	until     map[string]time.Time
+	path      string    // Set by Persist
+	modTime   time.Time // Of path when last read
+	refreshed time.Time // When path was last checked for changes
+}
+
+// newSnoozeLog creates an empty snooze log
+func newSnoozeLog() *snoozeLog {
+	return &snoozeLog{until: make(map[string]time.Time)}
+}
+
+// Persist loads the snoozes recorded at path and saves changes there
+func (l *snoozeLog) Persist(path string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.path = path
+	return l.reload()
+}
+
+// reload reads the snooze file if it changed since it was last read
+func (l *snoozeLog) reload() error {
+	info, err := os.Stat(l.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read snoozes file: %w", err)
+	}
+	if info.ModTime().Equal(l.modTime) {
+		return nil
+	}
+
+	until, err := LoadSnoozes(l.path)
+	if err != nil {
+		return err
+	}
+	l.until = until
+	l.modTime = info.ModTime()
+	return nil
+}
+
+// Until returns when a service's snooze ends, if it's snoozed at now
+func (l *snoozeLog) Until(serviceName string, now time.Time) (time.Time, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.path != "" && now.Sub(l.refreshed) >= snoozeRefreshInterval {
+		l.refreshed = now
+		_ = l.reload()
+	}
+
+	until, ok := l.until[serviceName]
+	return until, ok && now.Before(until)
+}
+
+// Set snoozes a service until the given time, or clears its snooze when
+// until is zero, saving the change when persisted
+func (l *snoozeLog) Set(serviceName string, until time.Time) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Keep snoozes set from the command line since the last read
+	if l.path != "" {
+		if err := l.reload(); err != nil {
+			return err
+		}
+	}
+
+	if until.IsZero() {
+		delete(l.until, serviceName)
+	} else {
+		l.until[serviceName] = until
+	}
+	if l.path == "" {
+		return nil
+	}
+
+	if err := SaveSnoozes(l.path, l.until); err != nil {
+		return err
+	}
+	if info, err := os.Stat(l.path); err == nil {
+		l.modTime = info.ModTime()
+	}
+	return nil
+}
+
+// LoadSnoozes reads the snooze deadlines recorded at path, keyed by service
+// name. A missing file has no snoozes.
+func LoadSnoozes(path string) (map[string]time.Time, error) {
+	until := make(map[string]time.Time)
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return until, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snoozes file: %w", err)
+	}
+	if err := json.Unmarshal(data, &until); err != nil {
+		return nil, fmt.Errorf("invalid snoozes file %s: %w", path, err)
+	}
+	return until, nil
+}
+
+// SaveSnoozes writes snooze deadlines to path, dropping any that have
+// passed. The file is replaced atomically so a running monitor never reads
+// a partial write.
+func SaveSnoozes(path string, until map[string]time.Time) error {
+	active := make(map[string]time.Time, len(until))
+	now := time.Now()
+	for serviceName, deadline := range until {
+		if deadline.After(now) {
+			active[serviceName] = deadline
+		}
+	}
+
+	data, err := json.MarshalIndent(active, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode snoozes: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create snoozes directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".snoozes-*")
+	if err != nil {
+		return fmt.Errorf("failed to write snoozes file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write snoozes file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write snoozes file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write snoozes file: %w", err)
+	}
+	return nil
+}
+
+// SnoozeService suppresses a service's notifications until the given time,
+// after which they resume on their own
+func (m *Monitor) SnoozeService(serviceName string, until time.Time) error {
+	return m.snoozes.Set(serviceName, until)
+}
+
+// UnsnoozeService resumes a snoozed service's notifications
+func (m *Monitor) UnsnoozeService(serviceName string) error {
+	return m.snoozes.Set(serviceName, time.Time{})
+}
+
+// SnoozedUntil returns when a service's snooze ends, if it's snoozed
+func (m *Monitor) SnoozedUntil(serviceName string) (time.Time, bool) {
+	return m.snoozes.Until(serviceName, time.Now())
+}
+
+// PersistSnoozes loads the snoozes recorded at path, where
+// `scout service:snooze` writes them, and saves changes made from the
+// dashboard there
+func (m *Monitor) PersistSnoozes(path string) error {
+	return m.snoozes.Persist(path)
+}
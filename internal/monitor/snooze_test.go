@@ -0,0 +1,62 @@
+package monitor
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSnoozeLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snoozes.json")
+	now := time.Now()
+
+	log := newSnoozeLog()
+	if err := log.Persist(path); err != nil {
+		t.Fatalf("Persist failed: %v", err)
+	}
+	if err := log.Set("api", now.Add(time.Hour)); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+	if until, ok := log.Until("api", now); !ok || !until.Equal(now.Add(time.Hour)) {
+		t.Errorf("Expected api snoozed for an hour, got %v, %v", until, ok)
+	}
+
+	// A restart keeps the active snooze
+	restarted := newSnoozeLog()
+	if err := restarted.Persist(path); err != nil {
+		t.Fatalf("Persist failed: %v", err)
+	}
+	if _, ok := restarted.Until("api", now); !ok {
+		t.Error("Expected the snooze to survive a restart")
+	}
+
+	// Snoozes written by service:snooze are picked up by a running monitor
+	snoozes, err := LoadSnoozes(path)
+	if err != nil {
+		t.Fatalf("LoadSnoozes failed: %v", err)
+	}
+	snoozes["web"] = now.Add(time.Hour)
+	snoozes["old"] = now.Add(-time.Minute)
+	if err := SaveSnoozes(path, snoozes); err != nil {
+		t.Fatalf("SaveSnoozes failed: %v", err)
+	}
+	if _, ok := log.Until("web", now.Add(snoozeRefreshInterval)); !ok {
+		t.Error("Expected a snooze saved to the file to be picked up")
+	}
+	if snoozes, _ := LoadSnoozes(path); len(snoozes) != 2 {
+		t.Errorf("Expected expired snoozes to be dropped when saving, got %v", snoozes)
+	}
+
+	if err := log.Set("api", time.Time{}); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+	if _, ok := log.Until("api", now); ok {
+		t.Error("Expected clearing the snooze to resume notifications")
+	}
+	if _, ok := log.Until("web", now); !ok {
+		t.Error("Expected clearing one snooze to keep the others")
+	}
+	if _, ok := log.Until("web", now.Add(2*time.Hour)); ok {
+		t.Error("Expected the snooze to end at its deadline")
+	}
+}
@@ -0,0 +1,105 @@
+package monitor
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// Stats summarizes latency and availability across a set of results
+type Stats struct {
+	ServiceName string
+	Samples     int
+	Min         time.Duration
+	Avg         time.Duration
+	P50         time.Duration
+	P95         time.Duration
+	P99         time.Duration
+	Max         time.Duration
+	Uptime      float64 // Percentage of healthy results (0-100)
+}
+
+// ComputeStats calculates aggregate statistics for a service's results
+func ComputeStats(serviceName string, results []Result) Stats {
+	stats := Stats{
+		ServiceName: serviceName,
+		Samples:     len(results),
+	}
+	if len(results) == 0 {
+		return stats
+	}
+
+	latencies := make([]time.Duration, 0, len(results))
+	var total time.Duration
+	healthy := 0
+	for _, result := range results {
+		latencies = append(latencies, result.ResponseTime)
+		total += result.ResponseTime
+		if result.Status == StatusHealthy {
+			healthy++
+		}
+	}
+
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+	stats.Min = latencies[0]
+	stats.Max = latencies[len(latencies)-1]
+	stats.Avg = total / time.Duration(len(latencies))
+	stats.P50 = percentile(latencies, 50)
+	stats.P95 = percentile(latencies, 95)
+	stats.P99 = percentile(latencies, 99)
+	stats.Uptime = float64(healthy) / float64(len(results)) * 100
+
+	return stats
+}
+
+// percentile returns the nearest-rank percentile of sorted durations
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// StatusCodeShare is how often a status code appeared across a set of results
+type StatusCodeShare struct {
+	Code    int // 0 for checks that got no response
+	Count   int
+	Percent float64 // Share of the results considered (0-100)
+}
+
+// StatusCodeBreakdown returns the distribution of status codes across the
+// last window results (all of them if window < 1), most frequent first
+func StatusCodeBreakdown(results []Result, window int) []StatusCodeShare {
+	if window > 0 && len(results) > window {
+		results = results[len(results)-window:]
+	}
+	if len(results) == 0 {
+		return nil
+	}
+
+	counts := make(map[int]int)
+	for _, result := range results {
+		counts[result.StatusCode]++
+	}
+
+	shares := make([]StatusCodeShare, 0, len(counts))
+	for code, count := range counts {
+		shares = append(shares, StatusCodeShare{
+			Code:    code,
+			Count:   count,
+			Percent: float64(count) / float64(len(results)) * 100,
+		})
+	}
+	sort.Slice(shares, func(i, j int) bool {
+		if shares[i].Count != shares[j].Count {
+			return shares[i].Count > shares[j].Count
+		}
+		return shares[i].Code < shares[j].Code
+	})
+	return shares
+}
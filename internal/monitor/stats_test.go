@@ -0,0 +1,96 @@
+package monitor
+
+import (
+	"testing"
+	"time"
+)
+
+func TestComputeStats(t *testing.T) {
+	var results []Result
+	for i := 1; i <= 100; i++ {
+		status := StatusHealthy
+		if i%10 == 0 {
+			status = StatusUnhealthy
+		}
+		results = append(results, Result{
+			ServiceName:  "api",
+			Status:       status,
+			ResponseTime: time.Duration(i) * time.Millisecond,
+		})
+	}
+
+	stats := ComputeStats("api", results)
+
+	if stats.Samples != 100 {
+		t.Errorf("Expected 100 samples, got %d", stats.Samples)
+	}
+	if stats.Min != time.Millisecond {
+		t.Errorf("Expected min 1ms, got %v", stats.Min)
+	}
+	if stats.Max != 100*time.Millisecond {
+		t.Errorf("Expected max 100ms, got %v", stats.Max)
+	}
+	if stats.Avg != 50500*time.Microsecond {
+		t.Errorf("Expected avg 50.5ms, got %v", stats.Avg)
+	}
+	if stats.P50 != 50*time.Millisecond {
+		t.Errorf("Expected p50 50ms, got %v", stats.P50)
+	}
+	if stats.P95 != 95*time.Millisecond {
+		t.Errorf("Expected p95 95ms, got %v", stats.P95)
+	}
+	if stats.P99 != 99*time.Millisecond {
+		t.Errorf("Expected p99 99ms, got %v", stats.P99)
+	}
+	if stats.Uptime != 90 {
+		t.Errorf("Expected uptime 90%%, got %.2f", stats.Uptime)
+	}
+}
+
+func TestComputeStatsEmpty(t *testing.T) {
+	stats := ComputeStats("api", nil)
+	if stats.Samples != 0 || stats.Uptime != 0 {
+		t.Errorf("Expected zero stats for no results, got %+v", stats)
+	}
+}
+
+func TestStatusCodeBreakdown(t *testing.T) {
+	var results []Result
+	// Older results outside the window
+	for i := 0; i < 10; i++ {
+		results = append(results, Result{StatusCode: 500})
+	}
+	for i := 0; i < 20; i++ {
+		code := 200
+		switch {
+		case i < 3:
+			code = 503
+		case i == 3:
+			code = 0
+		}
+		results = append(results, Result{StatusCode: code})
+	}
+
+	shares := StatusCodeBreakdown(results, 20)
+
+	expected := []StatusCodeShare{
+		{Code: 200, Count: 16, Percent: 80},
+		{Code: 503, Count: 3, Percent: 15},
+		{Code: 0, Count: 1, Percent: 5},
+	}
+	if len(shares) != len(expected) {
+		t.Fatalf("Expected %d shares, got %+v", len(expected), shares)
+	}
+	for i, want := range expected {
+		if shares[i] != want {
+			t.Errorf("Share %d: expected %+v, got %+v", i, want, shares[i])
+		}
+	}
+
+	if all := StatusCodeBreakdown(results, 0); all[0].Count != 16 || len(all) != 4 {
+		t.Errorf("Expected the whole history without a window, got %+v", all)
+	}
+	if StatusCodeBreakdown(nil, 20) != nil {
+		t.Error("Expected no shares for an empty history")
+	}
+}
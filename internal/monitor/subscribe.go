@@ -0,0 +1,40 @@
+package monitor
+
+// subscriberBuffer is how many results a slow subscriber can fall behind by
+// before results are dropped for it
+const subscriberBuffer = 64
+
+// Subscribe returns a channel receiving every completed check result, and a
+// function to stop receiving. Delivery never blocks the monitor; results are
+// dropped for subscribers that fall too far behind.
+func (m *Monitor) Subscribe() (<-chan Result, func()) {
+	ch := make(chan Result, subscriberBuffer)
+
+	m.muSubscribersLock.Lock()
+	m.subscribers[ch] = struct{}{}
+	m.muSubscribersLock.Unlock()
+
+	unsubscribe := func() {
+		m.muSubscribersLock.Lock()
+		defer m.muSubscribersLock.Unlock()
+		if _, ok := m.subscribers[ch]; ok {
+			delete(m.subscribers, ch)
+			close(ch)
+		}
+	}
+
+	return ch, unsubscribe
+}
+
+// publish delivers a completed result to all subscribers without blocking
+func (m *Monitor) publish(result Result) {
+	m.muSubscribersLock.RLock()
+	defer m.muSubscribersLock.RUnlock()
+
+	for ch := range m.subscribers {
+		select {
+		case ch <- result:
+		default:
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package monitor
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+// Timing breaks an HTTP check's response time into connection phases.
+// Phases skipped by a reused connection, or hidden by a proxy, are zero.
+type Timing struct {
+	DNS     time.Duration // Hostname lookup
+	Connect time.Duration // TCP connect
+	TLS     time.Duration // TLS handshake
+	TTFB    time.Duration // From starting the request to the first response byte
+}
+
+// timingTrace records phase timings from httptrace callbacks, which may
+// arrive on the transport's dialing goroutines
+type timingTrace struct {
+	mu           sync.Mutex
+	start        time.Time
+	dnsStart     time.Time
+	connectStart time.Time
+	tlsStart     time.Time
+	timing       Timing
+}
+
+// traceRequest returns req with a trace attached, starting the clock
+func traceRequest(req *http.Request) (*http.Request, *timingTrace) {
+	t := &timingTrace{start: time.Now()}
+
+	trace := &httptrace.ClientTrace{
+		DNSStart: func(httptrace.DNSStartInfo) {
+			t.mark(&t.dnsStart)
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			t.elapsed(&t.dnsStart, &t.timing.DNS)
+		},
+		ConnectStart: func(_, _ string) {
+			t.mark(&t.connectStart)
+		},
+		ConnectDone: func(_, _ string, _ error) {
+			t.elapsed(&t.connectStart, &t.timing.Connect)
+		},
+		TLSHandshakeStart: func() {
+			t.mark(&t.tlsStart)
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			t.elapsed(&t.tlsStart, &t.timing.TLS)
+		},
+		GotFirstResponseByte: func() {
+			t.elapsed(&t.start, &t.timing.TTFB)
+		},
+	}
+
+	return req.WithContext(httptrace.WithClientTrace(req.Context(), trace)), t
+}
+
+func (t *timingTrace) mark(at *time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	*at = time.Now()
+}
+
+// elapsed records the time since a mark, keeping the first value when
+// parallel dials report a phase more than once
+func (t *timingTrace) elapsed(since *time.Time, phase *time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !since.IsZero() && *phase == 0 {
+		*phase = time.Since(*since)
+	}
+}
+
+// Timing returns the phases recorded so far
+func (t *timingTrace) Timing() *Timing {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	timing := t.timing
+	return &timing
+}
@@ -0,0 +1,56 @@
+package monitor
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultTransitionLogSize is the number of status transitions kept
+const DefaultTransitionLogSize = 200
+
+// Transition records a service changing status
+type Transition struct {
+	ServiceName string
+	From        Status
+	To          Status
+	Message     string
+	At          time.Time
+}
+
+// TransitionLog keeps a bounded, in-memory record of status transitions
+type TransitionLog struct {
+	mu          sync.RWMutex
+	size        int
+	transitions []Transition
+}
+
+// NewTransitionLog creates a log that keeps up to size transitions
+func NewTransitionLog(size int) *TransitionLog {
+	if size < 1 {
+		size = DefaultTransitionLogSize
+	}
+	return &TransitionLog{size: size}
+}
+
+// Add records a transition, evicting the oldest once the log is full
+func (l *TransitionLog) Add(transition Transition) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.transitions = append(l.transitions, transition)
+	if len(l.transitions) > l.size {
+		l.transitions = l.transitions[len(l.transitions)-l.size:]
+	}
+}
+
+// Recent returns the recorded transitions, newest first
+func (l *TransitionLog) Recent() []Transition {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	recent := make([]Transition, len(l.transitions))
+	for i, transition := range l.transitions {
+		recent[len(l.transitions)-1-i] = transition
+	}
+	return recent
+}
@@ -0,0 +1,112 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+// stallMultiplier is how many check intervals a service may go without
+// reporting, while a check is running, before the watchdog gives up on it
+const stallMultiplier = 3
+
+// checkRun tracks a single in-flight check of a service
+type checkRun struct {
+	started   time.Time
+	done      chan struct{} // Closed when the check finishes
+	abandoned chan struct{} // Closed when the watchdog gives up on the check
+}
+
+// beginCheck registers a check of a service, reporting false if one is
+// already running so hung checks don't pile up
+func (m *Monitor) beginCheck(serviceName string) (*checkRun, bool) {
+	m.muWatchdogLock.Lock()
+	defer m.muWatchdogLock.Unlock()
+
+	if _, running := m.inFlight[serviceName]; running {
+		return nil, false
+	}
+	run := &checkRun{
+		started:   time.Now(),
+		done:      make(chan struct{}),
+		abandoned: make(chan struct{}),
+	}
+	m.inFlight[serviceName] = run
+	return run, true
+}
+
+// endCheck marks a check finished, recording when the service last reported
+func (m *Monitor) endCheck(serviceName string, run *checkRun) {
+	m.muWatchdogLock.Lock()
+	defer m.muWatchdogLock.Unlock()
+
+	close(run.done)
+	if m.inFlight[serviceName] == run {
+		delete(m.inFlight, serviceName)
+		m.lastReported[serviceName] = time.Now()
+	}
+}
+
+// isAbandoned reports whether the watchdog gave up on a check, in which
+// case its late result is dropped
+func (run *checkRun) isAbandoned() bool {
+	select {
+	case <-run.abandoned:
+		return true
+	default:
+		return false
+	}
+}
+
+// watch flags services whose checks have stalled until ctx is done
+func (m *Monitor) watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			for _, result := range m.abandonStalled(now, stallMultiplier*interval) {
+				log.Printf("watchdog: %s: %s, retrying next round", result.ServiceName, result.Message)
+				m.publish(result)
+				m.sendResult(ctx, result)
+			}
+		}
+	}
+}
+
+// abandonStalled gives up on checks of services that haven't reported for
+// longer than stallAfter, freeing them to be checked afresh next round, and
+// returns a stalled result for each
+func (m *Monitor) abandonStalled(now time.Time, stallAfter time.Duration) []Result {
+	m.muWatchdogLock.Lock()
+	defer m.muWatchdogLock.Unlock()
+
+	var stalled []Result
+	for name, run := range m.inFlight {
+		since := m.lastReported[name]
+		if since.IsZero() {
+			since = run.started
+		}
+		silent := now.Sub(since)
+		if silent <= stallAfter {
+			continue
+		}
+
+		close(run.abandoned)
+		delete(m.inFlight, name)
+		// Restart the stall clock so a check that hangs again is flagged again
+		m.lastReported[name] = now
+
+		stalled = append(stalled, Result{
+			ServiceName: name,
+			Status:      StatusStalled,
+			Message:     fmt.Sprintf("No result for %s", silent.Round(time.Second)),
+			CheckedAt:   now,
+		})
+	}
+	return stalled
+}
@@ -0,0 +1,162 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DigestTitle is the title of periodic digest notifications
+const DigestTitle = "Scout digest"
+
+// digest accumulates status changes between periodic summary notifications
+type digest struct {
+	interval time.Duration
+	only     bool // Suppress per-transition notifications
+
+	mu       sync.Mutex
+	services []string // In order of first change, for a stable summary
+	counts   map[string]*digestCounts
+}
+
+// digestCounts tallies one service's changes within a digest period
+type digestCounts struct {
+	down      int
+	recovered int
+	degraded  int
+}
+
+// record tallies a status change
+func (d *digest) record(serviceName string, status, previousStatus Status) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	counts, exists := d.counts[serviceName]
+	if !exists {
+		counts = &digestCounts{}
+	}
+
+	switch {
+	case status == Status("unhealthy") && (previousStatus == Status("healthy") || previousStatus == Status("unknown")):
+		counts.down++
+	case status == Status("healthy") && previousStatus == Status("unhealthy"):
+		counts.recovered++
+	case previousStatus == Status("healthy") && status != Status("checking"):
+		counts.degraded++
+	default:
+		return
+	}
+
+	if !exists {
+		d.counts[serviceName] = counts
+		d.services = append(d.services, serviceName)
+	}
+}
+
+// flush returns the summary of changes since the last flush and resets the
+// tallies, reporting false when nothing changed
+func (d *digest) flush() (string, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if len(d.services) == 0 {
+		return "", false
+	}
+
+	var parts []string
+	degraded := 0
+	for _, name := range d.services {
+		counts := d.counts[name]
+		if counts.down > 0 {
+			parts = append(parts, fmt.Sprintf("%s went down %s", name, times(counts.down)))
+		}
+		if counts.recovered > 0 {
+			parts = append(parts, fmt.Sprintf("%s recovered %s", name, times(counts.recovered)))
+		}
+		if counts.degraded > 0 {
+			degraded++
+		}
+	}
+	switch degraded {
+	case 0:
+	case 1:
+		parts = append(parts, "1 service degraded")
+	default:
+		parts = append(parts, fmt.Sprintf("%d services degraded", degraded))
+	}
+
+	d.services = nil
+	d.counts = make(map[string]*digestCounts)
+
+	return fmt.Sprintf("Over the last %s: %s.", shortDuration(d.interval), strings.Join(parts, ", ")), true
+}
+
+// times spells out a count of occurrences
+func times(n int) string {
+	switch n {
+	case 1:
+		return "once"
+	case 2:
+		return "twice"
+	default:
+		return fmt.Sprintf("%d times", n)
+	}
+}
+
+// shortDuration formats a duration without zero units, e.g. "1h" rather
+// than "1h0m0s"
+func shortDuration(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+	return s
+}
+
+// EnableDigest buffers status changes into a summary sent every interval by
+// RunDigest. When only is set, per-transition notifications are suppressed.
+func (n *Notifier) EnableDigest(interval time.Duration, only bool) {
+	n.digest = &digest{
+		interval: interval,
+		only:     only,
+		counts:   make(map[string]*digestCounts),
+	}
+}
+
+// RunDigest sends a digest notification every interval until ctx is done.
+// Periods without changes send nothing.
+func (n *Notifier) RunDigest(ctx context.Context) {
+	if n.digest == nil {
+		return
+	}
+
+	ticker := time.NewTicker(n.digest.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n.FlushDigest()
+		}
+	}
+}
+
+// FlushDigest sends a digest of the changes recorded since the last one
+func (n *Notifier) FlushDigest() {
+	if n.digest == nil {
+		return
+	}
+
+	message, ok := n.digest.flush()
+	if !ok || !n.Enabled() {
+		return
+	}
+	_ = send(DigestTitle, message, UrgencyNormal, false)
+}
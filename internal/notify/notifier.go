@@ -2,9 +2,17 @@ package notify
 
 import (
 	"fmt"
+	"strings"
+	"sync"
+	"text/template"
 	"time"
+)
 
-	"github.com/martinlindhe/notify"
+const (
+	DefaultFailureTitle    = "⚠️  {{.ServiceName}} - Health Check Failed"
+	DefaultFailureMessage  = "{{.Message}}{{if .Error}}: {{.Error}}{{end}}"
+	DefaultRecoveryTitle   = "✅ {{.ServiceName}} - Health Check Recovered"
+	DefaultRecoveryMessage = "Response time: {{.ResponseTime}}"
 )
 
 // Status represents a health check status
@@ -21,53 +29,143 @@ type CheckResult struct {
 	Message      string
 }
 
+// Templates holds text/template strings for notification titles and messages.
+// Empty fields fall back to the defaults.
+type Templates struct {
+	FailureTitle    string
+	FailureMessage  string
+	RecoveryTitle   string
+	RecoveryMessage string
+}
+
 // Notifier sends desktop notifications for health check events
 type Notifier struct {
-	enabled bool
+	enabled         bool
+	mu              sync.RWMutex
+	failureTitle    *template.Template
+	failureMessage  *template.Template
+	recoveryTitle   *template.Template
+	recoveryMessage *template.Template
+	alerting        Alerting
+	digest          *digest // Set by EnableDigest
 }
 
-// NewNotifier creates a new notifier instance
-func NewNotifier(enabled bool) *Notifier {
-	return &Notifier{
-		enabled: enabled,
+// NewNotifier creates a new notifier instance, parsing the given templates
+func NewNotifier(enabled bool, templates Templates, alerting Alerting) (*Notifier, error) {
+	n := &Notifier{
+		enabled:  enabled,
+		alerting: alerting,
+	}
+	if n.alerting.FailureUrgency == "" {
+		n.alerting.FailureUrgency = UrgencyNormal
+	}
+	if n.alerting.RecoveryUrgency == "" {
+		n.alerting.RecoveryUrgency = UrgencyNormal
+	}
+
+	var err error
+	if n.failureTitle, err = parseTemplate("failure_title", templates.FailureTitle, DefaultFailureTitle); err != nil {
+		return nil, err
+	}
+	if n.failureMessage, err = parseTemplate("failure_message", templates.FailureMessage, DefaultFailureMessage); err != nil {
+		return nil, err
 	}
+	if n.recoveryTitle, err = parseTemplate("recovery_title", templates.RecoveryTitle, DefaultRecoveryTitle); err != nil {
+		return nil, err
+	}
+	if n.recoveryMessage, err = parseTemplate("recovery_message", templates.RecoveryMessage, DefaultRecoveryMessage); err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// SetEnabled enables or disables (mutes) notifications at runtime
+func (n *Notifier) SetEnabled(enabled bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.enabled = enabled
+}
+
+// Enabled returns whether notifications are currently enabled
+func (n *Notifier) Enabled() bool {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.enabled
+}
+
+// parseTemplate parses text, or fallback when text is empty
+func parseTemplate(name, text, fallback string) (*template.Template, error) {
+	if text == "" {
+		text = fallback
+	}
+
+	tmpl, err := template.New(name).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s template: %w", name, err)
+	}
+
+	return tmpl, nil
+}
+
+// render executes a template against a check result
+func render(tmpl *template.Template, result CheckResult) (string, error) {
+	var b strings.Builder
+	if err := tmpl.Execute(&b, result); err != nil {
+		return "", fmt.Errorf("failed to render %s template: %w", tmpl.Name(), err)
+	}
+	return b.String(), nil
 }
 
 // NotifyFailure sends a desktop notification when a service check fails
 func (n *Notifier) NotifyFailure(result CheckResult) error {
-	if !n.enabled {
+	if !n.Enabled() {
 		return nil
 	}
 
-	title := fmt.Sprintf("⚠️  %s - Health Check Failed", result.ServiceName)
-	message := result.Message
-	if result.Error != nil {
-		message = fmt.Sprintf("%s: %v", result.Message, result.Error)
+	title, err := render(n.failureTitle, result)
+	if err != nil {
+		return err
+	}
+	message, err := render(n.failureMessage, result)
+	if err != nil {
+		return err
 	}
 
-	notify.Notify("Scout", title, message, "")
-	return nil
+	return send(title, message, n.alerting.FailureUrgency, n.alerting.FailureSound)
 }
 
 // NotifyRecovery sends a desktop notification when a service recovers
 func (n *Notifier) NotifyRecovery(result CheckResult) error {
-	if !n.enabled {
+	if !n.Enabled() {
 		return nil
 	}
 
-	title := fmt.Sprintf("✅ %s - Health Check Recovered", result.ServiceName)
-	message := fmt.Sprintf("Response time: %s", result.ResponseTime.String())
+	title, err := render(n.recoveryTitle, result)
+	if err != nil {
+		return err
+	}
+	message, err := render(n.recoveryMessage, result)
+	if err != nil {
+		return err
+	}
 
-	notify.Notify("Scout", title, message, "")
-	return nil
+	return send(title, message, n.alerting.RecoveryUrgency, false)
 }
 
 // NotifyStatusChange sends a desktop notification when a service status changes
 func (n *Notifier) NotifyStatusChange(result CheckResult, previousStatus Status) error {
-	if !n.enabled {
+	if !n.Enabled() {
 		return nil
 	}
 
+	if n.digest != nil {
+		n.digest.record(result.ServiceName, result.Status, previousStatus)
+		if n.digest.only {
+			return nil
+		}
+	}
+
 	healthyStatus := Status("healthy")
 	unhealthyStatus := Status("unhealthy")
 
@@ -0,0 +1,140 @@
+package notify
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNotifierDefaultTemplates(t *testing.T) {
+	n, err := NewNotifier(true, Templates{}, Alerting{})
+	if err != nil {
+		t.Fatalf("NewNotifier failed: %v", err)
+	}
+
+	result := CheckResult{
+		ServiceName:  "api",
+		Status:       Status("unhealthy"),
+		ResponseTime: 150 * time.Millisecond,
+		Message:      "Connection failed",
+		Error:        errors.New("connection refused"),
+	}
+
+	title, err := render(n.failureTitle, result)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	if title != "⚠️  api - Health Check Failed" {
+		t.Errorf("Unexpected failure title: %q", title)
+	}
+
+	message, err := render(n.failureMessage, result)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	if message != "Connection failed: connection refused" {
+		t.Errorf("Unexpected failure message: %q", message)
+	}
+
+	message, err = render(n.recoveryMessage, result)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	if message != "Response time: 150ms" {
+		t.Errorf("Unexpected recovery message: %q", message)
+	}
+}
+
+func TestNotifierCustomTemplates(t *testing.T) {
+	n, err := NewNotifier(true, Templates{
+		FailureTitle: "[ALERT] {{.ServiceName}} returned {{.StatusCode}}",
+	}, Alerting{})
+	if err != nil {
+		t.Fatalf("NewNotifier failed: %v", err)
+	}
+
+	title, err := render(n.failureTitle, CheckResult{ServiceName: "api", StatusCode: 503})
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	if title != "[ALERT] api returned 503" {
+		t.Errorf("Unexpected failure title: %q", title)
+	}
+
+	// Invalid templates are rejected at construction
+	if _, err := NewNotifier(true, Templates{RecoveryTitle: "{{.ServiceName"}, Alerting{}); err == nil {
+		t.Error("Expected error for invalid template")
+	}
+}
+
+func TestNotifierSetEnabled(t *testing.T) {
+	n, err := NewNotifier(false, Templates{}, Alerting{})
+	if err != nil {
+		t.Fatalf("NewNotifier failed: %v", err)
+	}
+	if n.Enabled() {
+		t.Error("Expected notifier to start disabled")
+	}
+
+	n.SetEnabled(true)
+	if !n.Enabled() {
+		t.Error("Expected notifier to be enabled after SetEnabled(true)")
+	}
+}
+
+func TestParseUrgency(t *testing.T) {
+	for value, expected := range map[string]Urgency{
+		"":         UrgencyNormal,
+		"low":      UrgencyLow,
+		"critical": UrgencyCritical,
+	} {
+		urgency, err := ParseUrgency(value)
+		if err != nil || urgency != expected {
+			t.Errorf("ParseUrgency(%q) = %q, %v; expected %q", value, urgency, err, expected)
+		}
+	}
+
+	if _, err := ParseUrgency("urgent"); err == nil {
+		t.Error("Expected error for invalid urgency")
+	}
+}
+
+func TestNotifierDigest(t *testing.T) {
+	n, err := NewNotifier(true, Templates{}, Alerting{})
+	if err != nil {
+		t.Fatalf("NewNotifier failed: %v", err)
+	}
+	n.EnableDigest(time.Hour, true)
+
+	changes := []struct {
+		service  string
+		status   Status
+		previous Status
+	}{
+		{"api", "unhealthy", "healthy"},
+		{"db", "healthy", "unhealthy"},
+		{"api", "healthy", "unhealthy"},
+		{"api", "unhealthy", "healthy"},
+		{"cache", "stalled", "healthy"},
+		{"queue", "stalled", "healthy"},
+		{"web", "healthy", "unknown"}, // First result, not a change worth reporting
+	}
+	for _, c := range changes {
+		if err := n.NotifyStatusChange(CheckResult{ServiceName: c.service, Status: c.status}, c.previous); err != nil {
+			t.Fatalf("NotifyStatusChange failed: %v", err)
+		}
+	}
+
+	message, ok := n.digest.flush()
+	if !ok {
+		t.Fatal("Expected a digest after status changes")
+	}
+	expected := "Over the last 1h: api went down twice, api recovered once, db recovered once, 2 services degraded."
+	if message != expected {
+		t.Errorf("Expected digest %q, got %q", expected, message)
+	}
+
+	if _, ok := n.digest.flush(); ok {
+		t.Error("Expected no digest after flushing")
+	}
+}
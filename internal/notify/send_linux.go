@@ -0,0 +1,52 @@
+package notify
+
+import (
+	"fmt"
+	"log"
+	"os/exec"
+	"strings"
+)
+
+// alertSound is the freedesktop sound played for alerts
+const alertSound = "/usr/share/sounds/freedesktop/stereo/alarm-clock-elapsed.oga"
+
+// send displays a notification via notify-send with the given urgency,
+// returning notify-send's own error output when it fails
+func send(title, message string, urgency Urgency, sound bool) error {
+	if output, err := exec.Command("notify-send", "-a", "Scout", "-u", string(urgency), title, message).CombinedOutput(); err != nil {
+		if detail := strings.TrimSpace(string(output)); detail != "" {
+			return fmt.Errorf("notify-send failed: %w: %s", err, detail)
+		}
+		return fmt.Errorf("notify-send failed: %w", err)
+	}
+
+	// Play the sound in the background so a slow or stuck audio server
+	// doesn't hold up the check that raised the alert
+	if sound {
+		player := exec.Command("paplay", alertSound)
+		if err := player.Start(); err != nil {
+			log.Println("error playing alert sound:", err)
+			return nil
+		}
+		go func() {
+			if err := player.Wait(); err != nil {
+				log.Println("error playing alert sound:", err)
+			}
+		}()
+	}
+	return nil
+}
+
+// CheckBackend reports whether the tools used to display notifications,
+// and play the alert sound when enabled, are installed
+func CheckBackend(sound bool) error {
+	if _, err := exec.LookPath("notify-send"); err != nil {
+		return fmt.Errorf("notify-send not found (install libnotify)")
+	}
+	if sound {
+		if _, err := exec.LookPath("paplay"); err != nil {
+			return fmt.Errorf("paplay not found (install pulseaudio-utils or disable failure_sound)")
+		}
+	}
+	return nil
+}
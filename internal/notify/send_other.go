@@ -0,0 +1,22 @@
+//go:build !linux
+
+package notify
+
+import "github.com/martinlindhe/notify"
+
+// send displays a notification; urgency isn't supported on this platform,
+// and the platform notifiers don't report delivery errors
+func send(title, message string, _ Urgency, sound bool) error {
+	if sound {
+		notify.Alert("Scout", title, message, "")
+		return nil
+	}
+	notify.Notify("Scout", title, message, "")
+	return nil
+}
+
+// CheckBackend reports whether notifications can be displayed; the
+// platform notifiers need no extra tools here
+func CheckBackend(_ bool) error {
+	return nil
+}
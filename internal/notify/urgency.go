@@ -0,0 +1,32 @@
+package notify
+
+import "fmt"
+
+// Urgency is the priority hint given to the desktop notification daemon
+type Urgency string
+
+const (
+	UrgencyLow      Urgency = "low"
+	UrgencyNormal   Urgency = "normal"
+	UrgencyCritical Urgency = "critical"
+)
+
+// Alerting controls how prominently failures and recoveries are shown.
+// Urgency is only honored where the platform supports it (Linux).
+type Alerting struct {
+	FailureUrgency  Urgency
+	RecoveryUrgency Urgency
+	FailureSound    bool // Play the system alert sound on failures
+}
+
+// ParseUrgency validates an urgency level, defaulting empty values to normal
+func ParseUrgency(value string) (Urgency, error) {
+	switch Urgency(value) {
+	case "":
+		return UrgencyNormal, nil
+	case UrgencyLow, UrgencyNormal, UrgencyCritical:
+		return Urgency(value), nil
+	default:
+		return "", fmt.Errorf("invalid urgency %q (expected low, normal, or critical)", value)
+	}
+}
@@ -0,0 +1,134 @@
+package tui
+
+import "github.com/charmbracelet/bubbles/key"
+
+// keyMap holds the dashboard's key bindings. The footer and help overlay
+// are rendered from it, so they always match what is actually bound.
+type keyMap struct {
+	Prev        key.Binding
+	Next        key.Binding
+	ScrollUp    key.Binding
+	ScrollDown  key.Binding
+	Detail      key.Binding
+	Error       key.Binding
+	Body        key.Binding
+	Copy        key.Binding
+	New         key.Binding
+	Pause       key.Binding
+	Mute        key.Binding
+	Snooze      key.Binding
+	Units       key.Binding
+	ColumnsUp   key.Binding
+	ColumnsDown key.Binding
+	Compare     key.Binding
+	Layout      key.Binding
+	Events      key.Binding
+	Help        key.Binding
+	Quit        key.Binding
+}
+
+// keys are the dashboard's key bindings
+var keys = keyMap{
+	Prev: key.NewBinding(
+		key.WithKeys("left", "h", "up", "k", "shift+tab"),
+		key.WithHelp("←/↑/h/k", "previous service"),
+	),
+	Next: key.NewBinding(
+		key.WithKeys("right", "l", "down", "j", "tab"),
+		key.WithHelp("→/↓/l/j", "next service"),
+	),
+	ScrollUp: key.NewBinding(
+		key.WithKeys("pgup", "K"),
+		key.WithHelp("pgup/K", "scroll up (page/line)"),
+	),
+	ScrollDown: key.NewBinding(
+		key.WithKeys("pgdown", "J"),
+		key.WithHelp("pgdn/J", "scroll down (page/line)"),
+	),
+	Detail: key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "details"),
+	),
+	Error: key.NewBinding(
+		key.WithKeys("e"),
+		key.WithHelp("e", "error details"),
+	),
+	Body: key.NewBinding(
+		key.WithKeys("b"),
+		key.WithHelp("b", "captured response"),
+	),
+	Copy: key.NewBinding(
+		key.WithKeys("c"),
+		key.WithHelp("c", "copy curl command"),
+	),
+	New: key.NewBinding(
+		key.WithKeys("n"),
+		key.WithHelp("n", "new service"),
+	),
+	Pause: key.NewBinding(
+		key.WithKeys("p"),
+		key.WithHelp("p", "pause/resume"),
+	),
+	Mute: key.NewBinding(
+		key.WithKeys("m"),
+		key.WithHelp("m", "mute notifications"),
+	),
+	Snooze: key.NewBinding(
+		key.WithKeys("z"),
+		key.WithHelp("z", "snooze service for 1h"),
+	),
+	Units: key.NewBinding(
+		key.WithKeys("u"),
+		key.WithHelp("u", "cycle latency units"),
+	),
+	ColumnsUp: key.NewBinding(
+		key.WithKeys("+", "="),
+		key.WithHelp("+", "more columns"),
+	),
+	ColumnsDown: key.NewBinding(
+		key.WithKeys("-"),
+		key.WithHelp("-", "fewer columns"),
+	),
+	Compare: key.NewBinding(
+		key.WithKeys("g"),
+		key.WithHelp("g", "compare groups"),
+	),
+	Layout: key.NewBinding(
+		key.WithKeys("v"),
+		key.WithHelp("v", "toggle cards/list"),
+	),
+	Events: key.NewBinding(
+		key.WithKeys("L"),
+		key.WithHelp("L", "status transitions"),
+	),
+	Help: key.NewBinding(
+		key.WithKeys("?"),
+		key.WithHelp("?", "help"),
+	),
+	Quit: key.NewBinding(
+		key.WithKeys("q", "ctrl+c"),
+		key.WithHelp("q", "quit"),
+	),
+}
+
+// ShortHelp returns the bindings listed in the footer
+func (k keyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Quit, k.New, k.Pause, k.Detail, k.Help}
+}
+
+// helpSection is a titled group of bindings in the help overlay
+type helpSection struct {
+	title    string
+	bindings []key.Binding
+}
+
+// FullHelp returns every binding, grouped for the help overlay
+func (k keyMap) FullHelp() []helpSection {
+	return []helpSection{
+		{"Navigation", []key.Binding{k.Prev, k.Next, k.ScrollUp, k.ScrollDown}},
+		{"Inspect", []key.Binding{k.Detail, k.Error, k.Body, k.Copy, k.Events}},
+		{"Services", []key.Binding{k.New, k.Pause, k.Mute, k.Snooze}},
+		{"Layout", []key.Binding{k.Units, k.ColumnsUp, k.ColumnsDown, k.Compare, k.Layout}},
+		{"General", []key.Binding{k.Help, k.Quit}},
+	}
+}
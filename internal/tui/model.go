@@ -4,8 +4,10 @@ import (
 	"time"
 
 	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/juststeveking/scout/internal/monitor"
 )
 
@@ -16,18 +18,42 @@ type Model struct {
 	height          int
 	lastUpdate      time.Time
 	quitting        bool
+	shuttingDown    bool
+	monitorStopped  bool  // The monitor closed its results channel; no more results will arrive
+	configDirty     bool  // A config save failed; retried on shutdown
+	shutdownErr     error // Why the config couldn't be saved on shutdown
 	monitor         *monitor.Monitor
 	monitorCancel   func()
 	spinners        map[string]spinner.Model
 	selectedIndex   int
+	scrollOffset    int // First visible line of the dashboard body
 	showDetail      bool
+	showHelp        bool
+	showCompare     bool
 	detailName      string
 	showErrorDetail bool
 	errorDetailName string
-	clipboardMsg    string
-	clipboardTime   time.Time
+	showCapture     bool
+	captureName     string
+	captureView     viewport.Model
+	showEvents      bool
+	eventsView      viewport.Model
+	toast           string
+	toastTime       time.Time
 	pausedServices  map[string]bool
 
+	// Display preferences
+	latencyUnit      string
+	latencyPrecision int
+	columns          int             // 0 sizes the grid automatically
+	listLayout       bool            // One line per service instead of the card grid
+	density          cardDensity     // Padding and margins around grid cards
+	cardFields       map[string]bool // Blocks shown on each service card
+	smoothLatency    bool            // Cards show the moving average latency
+	showCheckRate    bool            // Header shows checks per minute
+	spinner          spinner.Spinner // Animation for services being checked
+	spinnerColor     lipgloss.Color  // Overrides the theme's spinner color when set
+
 	// Form state
 	form     *huh.Form
 	showForm bool
@@ -47,6 +73,7 @@ type FormData struct {
 	AuthPassword   string
 	Headers        string // Formatted as key:value,key:value
 	JSONAssertions string // Formatted as path:value:operator,path:value:operator
+	Confirmed      bool
 }
 
 // ServiceState tracks the current state of a service
@@ -54,26 +81,144 @@ type ServiceState struct {
 	Name         string
 	Status       monitor.Status
 	ResponseTime time.Duration
+	AvgLatency   time.Duration // Moving average of ResponseTime
+	Timing       *monitor.Timing
+	Endpoints    []monitor.EndpointResult
+	Fields       []monitor.Field
+	Assertions   []monitor.AssertionResult
 	Message      string
+	Reason       monitor.FailureReason // Failure category shown as a tag on failing cards
+	RateLimited  bool                  // The latest check was skipped by max_checks_per_minute
 	LastChecked  time.Time
 	StatusCode   int
 	Error        error
 	IsChecking   bool
 	Checks       []string
+	CheckResults map[string]bool // Latest outcome of each label in Checks that ran
 	Paused       bool
 }
 
 // NewModel creates a new TUI model
 func NewModel(m *monitor.Monitor, cancel func()) Model {
-	return Model{
-		services:       make([]ServiceState, 0),
-		monitor:        m,
-		monitorCancel:  cancel,
-		lastUpdate:     time.Now(),
-		spinners:       make(map[string]spinner.Model),
-		selectedIndex:  0,
-		pausedServices: make(map[string]bool),
+	model := Model{
+		services:         make([]ServiceState, 0),
+		monitor:          m,
+		monitorCancel:    cancel,
+		lastUpdate:       time.Now(),
+		spinners:         make(map[string]spinner.Model),
+		selectedIndex:    0,
+		pausedServices:   make(map[string]bool),
+		latencyUnit:      latencyUnitAuto,
+		latencyPrecision: -1,
+		cardFields:       cardFieldSet(defaultCardFields),
+		spinner:          spinnerKinds[defaultSpinner],
+		density:          densities[densityNormal],
+	}
+
+	if m != nil && m.Config != nil {
+		display := m.Config.Display
+		if display.LatencyUnit != "" {
+			model.latencyUnit = display.LatencyUnit
+		}
+		if display.LatencyPrecision != nil {
+			model.latencyPrecision = *display.LatencyPrecision
+		}
+		if display.Columns > 0 {
+			model.columns = display.Columns
+		}
+		model.listLayout = display.Layout == layoutList
+		if density, ok := densities[display.Density]; ok {
+			model.density = density
+		}
+		if len(display.CardFields) > 0 {
+			model.cardFields = cardFieldSet(display.CardFields)
+		}
+		model.smoothLatency = display.SmoothLatency
+		model.showCheckRate = display.ShowCheckRate
+		if kind, ok := spinnerKinds[display.Spinner]; ok {
+			model.spinner = kind
+		}
+		if display.SpinnerFPS > 0 {
+			model.spinner.FPS = time.Second / time.Duration(display.SpinnerFPS)
+		}
+		model.spinnerColor = lipgloss.Color(display.SpinnerColor)
+		if t, ok := themes[display.Theme]; ok && themeOverride == "" {
+			applyTheme(t)
+		}
+	}
+
+	if themeOverride != "" {
+		applyTheme(themes[themeOverride])
 	}
+
+	model.addPendingServices()
+
+	return model
+}
+
+// snoozeDuration is how long the snooze key silences a service
+const snoozeDuration = time.Hour
+
+// snoozedUntil returns when a service's notification snooze ends, if it's
+// snoozed
+func (m Model) snoozedUntil(serviceName string) (time.Time, bool) {
+	if m.monitor == nil {
+		return time.Time{}, false
+	}
+	return m.monitor.SnoozedUntil(serviceName)
+}
+
+// Dashboard layouts
+const (
+	layoutCards = "cards"
+	layoutList  = "list"
+)
+
+// Latency display units
+const (
+	latencyUnitAuto = "auto"
+	latencyUnitMs   = "ms"
+	latencyUnitS    = "s"
+)
+
+// Service card fields, shown beneath each card's status icon and name
+const (
+	cardFieldStatusCode  = "status_code"
+	cardFieldLatency     = "latency"
+	cardFieldLatencyBar  = "latency_bar"
+	cardFieldChecks      = "checks"
+	cardFieldLastChecked = "last_checked"
+	cardFieldError       = "error"
+)
+
+// defaultCardFields matches the original card layout
+var defaultCardFields = []string{
+	cardFieldStatusCode,
+	cardFieldLatency,
+	cardFieldChecks,
+	cardFieldLastChecked,
+	cardFieldError,
+}
+
+// cardFieldSet converts a list of card fields into a lookup set
+func cardFieldSet(fields []string) map[string]bool {
+	set := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		set[field] = true
+	}
+	return set
+}
+
+// newSpinner returns the configured check animation, styled by the theme
+// unless a spinner color is set
+func (m Model) newSpinner() spinner.Model {
+	s := spinner.New()
+	s.Spinner = m.spinner
+	s.Style = spinnerStyle
+	if m.spinnerColor != "" {
+		s.Style = s.Style.Foreground(m.spinnerColor)
+	}
+	return s
 }
 
 // Init initializes the model
@@ -88,10 +233,17 @@ func (m Model) Init() tea.Cmd {
 // resultMsg wraps a monitor result for Bubble Tea
 type resultMsg monitor.Result
 
-// waitForResults listens for monitor results
+// monitorStoppedMsg is sent once the monitor has closed its results channel
+type monitorStoppedMsg struct{}
+
+// waitForResults listens for monitor results, reporting when the monitor
+// stops rather than delivering the closed channel's zero value
 func waitForResults(mon *monitor.Monitor) tea.Cmd {
 	return func() tea.Msg {
-		result := <-mon.Results()
+		result, ok := <-mon.Results()
+		if !ok {
+			return monitorStoppedMsg{}
+		}
 		return resultMsg(result)
 	}
 }
@@ -106,6 +258,27 @@ func doTick() tea.Cmd {
 	})
 }
 
+// shutdownTimeout bounds how long quitting waits for the monitor to stop
+const shutdownTimeout = 2 * time.Second
+
+// shutdownMsg is sent once the monitor has stopped and state is persisted,
+// with Err set when unsaved config couldn't be written
+type shutdownMsg struct {
+	Err error
+}
+
+// ShutdownErr returns why unsaved config couldn't be written on quit, so it
+// can be reported once the dashboard has closed
+func (m Model) ShutdownErr() error {
+	return m.shutdownErr
+}
+
+// ConfigReloadedMsg is sent when the config file has been reloaded
+type ConfigReloadedMsg struct {
+	Summary monitor.ReloadSummary
+	Err     error
+}
+
 // clipboardMsg is sent when clipboard operation completes
 type clipboardMsg struct {
 	success bool
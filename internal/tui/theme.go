@@ -0,0 +1,232 @@
+package tui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/juststeveking/scout/internal/monitor"
+)
+
+// Theme names
+const (
+	themeDefault    = "default"
+	themeColorblind = "colorblind"
+)
+
+// theme is a dashboard color palette
+type theme struct {
+	Accent    lipgloss.Color
+	Healthy   lipgloss.Color
+	Unhealthy lipgloss.Color
+	Checking  lipgloss.Color
+	Paused    lipgloss.Color
+	Muted     lipgloss.Color
+	Subtle    lipgloss.Color
+	Card      lipgloss.Color
+	Text      lipgloss.Color
+	Spinner   lipgloss.Color
+
+	// StatusLabels adds UP/DOWN text to cards so status isn't conveyed by color alone
+	StatusLabels bool
+}
+
+// themes are the available palettes by name
+var themes = map[string]theme{
+	themeDefault: {
+		Accent:    lipgloss.Color("#7dcfff"), // Softer Cyan
+		Healthy:   lipgloss.Color("#9ece6a"), // Soft Green
+		Unhealthy: lipgloss.Color("#f7768e"), // Soft Red
+		Checking:  lipgloss.Color("#e0af68"), // Warm Yellow
+		Paused:    lipgloss.Color("#565f89"), // Muted Blue for paused
+		Muted:     lipgloss.Color("#565f89"), // Muted Blue
+		Subtle:    lipgloss.Color("#414868"), // Lighter subtle
+		Card:      lipgloss.Color("#1a1b26"), // Softer dark background
+		Text:      lipgloss.Color("#c0caf5"), // Light Blue/White
+		Spinner:   lipgloss.Color("#FFD700"), // Gold
+	},
+	// Blue/orange palette distinguishable with red-green color blindness
+	themeColorblind: {
+		Accent:       lipgloss.Color("#cc79a7"), // Reddish Purple
+		Healthy:      lipgloss.Color("#56b4e9"), // Sky Blue
+		Unhealthy:    lipgloss.Color("#e69f00"), // Orange
+		Checking:     lipgloss.Color("#f0e442"), // Yellow
+		Paused:       lipgloss.Color("#565f89"),
+		Muted:        lipgloss.Color("#565f89"),
+		Subtle:       lipgloss.Color("#414868"),
+		Card:         lipgloss.Color("#1a1b26"),
+		Text:         lipgloss.Color("#c0caf5"),
+		Spinner:      lipgloss.Color("#f0e442"),
+		StatusLabels: true,
+	},
+}
+
+// defaultSpinner is the check animation used unless display.spinner is set
+const defaultSpinner = "minidot"
+
+// spinnerKinds are the available check animations by name
+var spinnerKinds = map[string]spinner.Spinner{
+	"minidot": spinner.MiniDot,
+	"dot":     spinner.Dot,
+	"line":    spinner.Line,
+	"pulse":   spinner.Pulse,
+	"points":  spinner.Points,
+}
+
+// cardDensity is the padding and margins around each grid card
+type cardDensity struct {
+	padY, padX                int
+	marginRight, marginBottom int
+}
+
+// Card densities
+const (
+	densityCompact     = "compact"
+	densityNormal      = "normal"
+	densityComfortable = "comfortable"
+)
+
+// densities maps each density to its card spacing; normal matches baseCardStyle
+var densities = map[string]cardDensity{
+	densityCompact:     {padY: 0, padX: 0, marginRight: 0, marginBottom: 0},
+	densityNormal:      {padY: 0, padX: 1, marginRight: 1, marginBottom: 1},
+	densityComfortable: {padY: 1, padX: 2, marginRight: 2, marginBottom: 1},
+}
+
+// frameDelta is how many more columns a card's padding and margins take
+// than at normal density
+func (d cardDensity) frameDelta() int {
+	normal := densities[densityNormal]
+	return 2*(d.padX-normal.padX) + d.marginRight - normal.marginRight
+}
+
+// themeOverride replaces the configured theme when set
+var themeOverride string
+
+// SetTheme overrides the configured dashboard theme by name
+func SetTheme(name string) error {
+	if _, ok := themes[name]; !ok {
+		return fmt.Errorf("unknown theme %q (expected %q or %q)", name, themeDefault, themeColorblind)
+	}
+	themeOverride = name
+	return nil
+}
+
+var (
+	activeTheme theme
+
+	colorAccent    lipgloss.Color
+	colorHealthy   lipgloss.Color
+	colorUnhealthy lipgloss.Color
+	colorChecking  lipgloss.Color
+	colorPaused    lipgloss.Color
+	colorMuted     lipgloss.Color
+	colorSubtle    lipgloss.Color
+	colorCard      lipgloss.Color
+	colorText      lipgloss.Color
+
+	titleStyle       lipgloss.Style
+	headerStyle      lipgloss.Style
+	healthyStyle     lipgloss.Style
+	unhealthyStyle   lipgloss.Style
+	checkingStyle    lipgloss.Style
+	pausedStyle      lipgloss.Style
+	baseCardStyle    lipgloss.Style
+	metadataStyle    lipgloss.Style
+	errorStyle       lipgloss.Style
+	serviceNameStyle lipgloss.Style
+	secondaryStyle   lipgloss.Style
+	spinnerStyle     lipgloss.Style
+)
+
+func init() {
+	applyTheme(themes[themeDefault])
+}
+
+// applyTheme sets the palette and rebuilds every style from it
+func applyTheme(t theme) {
+	activeTheme = t
+
+	colorAccent = t.Accent
+	colorHealthy = t.Healthy
+	colorUnhealthy = t.Unhealthy
+	colorChecking = t.Checking
+	colorPaused = t.Paused
+	colorMuted = t.Muted
+	colorSubtle = t.Subtle
+	colorCard = t.Card
+	colorText = t.Text
+
+	// Title style
+	titleStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(colorAccent).
+		MarginBottom(1)
+
+	// Subtitle/header style
+	headerStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(colorAccent).
+		MarginTop(1).
+		MarginBottom(1)
+
+	// Status indicators
+	healthyStyle = lipgloss.NewStyle().
+		Foreground(colorHealthy).
+		Bold(true)
+
+	unhealthyStyle = lipgloss.NewStyle().
+		Foreground(colorUnhealthy).
+		Bold(true)
+
+	checkingStyle = lipgloss.NewStyle().
+		Foreground(colorChecking).
+		Bold(true)
+
+	pausedStyle = lipgloss.NewStyle().
+		Foreground(colorPaused).
+		Bold(true)
+
+	// Base card style (border color will be overridden)
+	baseCardStyle = lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		Background(colorCard).
+		Padding(0, 1).
+		MarginRight(1).
+		MarginBottom(1)
+
+	// Metadata style
+	metadataStyle = lipgloss.NewStyle().
+		Foreground(colorMuted)
+
+	// Error style
+	errorStyle = lipgloss.NewStyle().
+		Foreground(colorUnhealthy)
+
+	// Service name style for grid
+	serviceNameStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(colorText)
+
+	// Secondary info style
+	secondaryStyle = lipgloss.NewStyle().
+		Foreground(colorMuted)
+
+	// Checking spinner style
+	spinnerStyle = lipgloss.NewStyle().
+		Foreground(t.Spinner)
+}
+
+// statusLabel returns a textual status for themes that don't rely on color alone
+func statusLabel(status monitor.Status) string {
+	switch status {
+	case monitor.StatusHealthy:
+		return "UP"
+	case monitor.StatusUnhealthy:
+		return "DOWN"
+	case monitor.StatusStalled:
+		return "STALLED"
+	default:
+		return ""
+	}
+}
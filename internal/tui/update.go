@@ -1,18 +1,20 @@
 package tui
 
 import (
-	"context"
 	"fmt"
+	"net/url"
 	"os/exec"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/huh"
-	"github.com/charmbracelet/lipgloss"
 	"github.com/juststeveking/scout/internal/config"
 	"github.com/juststeveking/scout/internal/monitor"
 )
@@ -41,6 +43,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.form = f
 		}
 
+		if m.form.State == huh.StateCompleted && !m.formData.Confirmed {
+			m.showForm = false
+			m.form = nil
+			return m, cmd
+		}
+
 		if m.form.State == huh.StateCompleted {
 			// Process form data
 			status, _ := strconv.Atoi(m.formData.ExpectedStatus)
@@ -78,10 +86,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				newService.JSONAssertions = assertions
 			}
 
-			// Add to config
-			if err := m.monitor.Config.AddService(newService); err == nil {
-				// Save config
-				config.SaveConfig(m.monitor.Config)
+			// Add to config and monitor (will send real results)
+			if err := m.monitor.AddService(newService); err != nil {
+				m.toast = fmt.Sprintf("✗ %v", err)
+				m.toastTime = time.Now()
+			} else {
+				// Save config, retrying on shutdown if it fails
+				m.saveConfig()
 
 				// Immediately surface the new service in the dashboard as "checking"
 				checks := m.buildCheckLabels(newService)
@@ -104,9 +115,6 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.services = append(m.services, placeholder)
 				}
 				m.clampSelection()
-
-				// Add to monitor (will send real results)
-				m.monitor.AddService(context.Background(), newService)
 			}
 
 			m.showForm = false
@@ -129,11 +137,52 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			switch msg.String() {
 			case "esc", "enter":
 				m.showErrorDetail = false
+			}
+			// When error detail is open, ignore other key input
+			return m, nil
+		}
+	}
+
+	// Handle capture pane interactions
+	if m.showCapture {
+		if msg, ok := msg.(tea.KeyMsg); ok {
+			switch msg.String() {
+			case "esc", "enter", "b":
+				m.showCapture = false
 				return m, nil
 			}
+			// Other keys scroll the pane
+			var cmd tea.Cmd
+			m.captureView, cmd = m.captureView.Update(msg)
+			return m, cmd
+		}
+	}
+
+	// Handle event feed interactions
+	if m.showEvents {
+		if msg, ok := msg.(tea.KeyMsg); ok {
+			switch msg.String() {
+			case "esc", "enter", "L":
+				m.showEvents = false
+				return m, nil
+			}
+			// Other keys scroll the feed
+			var cmd tea.Cmd
+			m.eventsView, cmd = m.eventsView.Update(msg)
+			return m, cmd
+		}
+	}
+
+	// Handle help overlay interactions
+	if m.showHelp {
+		if msg, ok := msg.(tea.KeyMsg); ok {
+			switch {
+			case msg.String() == "esc", key.Matches(msg, keys.Help):
+				m.showHelp = false
+			}
+			// When help is open, ignore other key input
+			return m, nil
 		}
-		// When error detail is open, ignore other input
-		return m, nil
 	}
 
 	// Handle detail modal interactions
@@ -142,32 +191,41 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			switch msg.String() {
 			case "esc", "enter":
 				m.showDetail = false
-				return m, nil
+			case "r":
+				// Re-check just this service immediately
+				if m.monitor != nil {
+					_ = m.monitor.CheckService(m.detailName)
+				}
 			}
+			// When detail is open, ignore other key input
+			return m, nil
 		}
-		// When detail is open, ignore other input
-		return m, nil
 	}
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "ctrl+c", "q":
-			m.quitting = true
+		switch {
+		case key.Matches(msg, keys.Quit):
+			if m.shuttingDown {
+				// A second quit skips waiting for the monitor
+				m.quitting = true
+				return m, tea.Quit
+			}
+			m.shuttingDown = true
 			if m.monitorCancel != nil {
 				m.monitorCancel()
 			}
-			return m, tea.Quit
-		case "n":
+			return m, m.shutdown()
+		case key.Matches(msg, keys.New):
 			m.showForm = true
 			m.initAddServiceForm()
 			return m, m.form.Init()
-		case "enter":
+		case key.Matches(msg, keys.Detail):
 			if len(m.services) > 0 {
 				m.detailName = m.getSelectedName()
 				m.showDetail = true
 			}
-		case "e":
+		case key.Matches(msg, keys.Error):
 			// Show error detail for selected service
 			if len(m.services) > 0 {
 				selectedName := m.getSelectedName()
@@ -180,7 +238,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					}
 				}
 			}
-		case "p":
+		case key.Matches(msg, keys.Pause):
 			// Toggle pause for selected service
 			if len(m.services) > 0 {
 				selectedName := m.getSelectedName()
@@ -201,7 +259,73 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					}
 				}
 			}
-		case "c":
+		case key.Matches(msg, keys.Mute):
+			// Toggle global notification mute
+			m.monitor.SetNotificationsEnabled(!m.monitor.NotificationsEnabled())
+		case key.Matches(msg, keys.Snooze):
+			// Snooze the selected service's notifications, or end its snooze
+			if len(m.services) > 0 && m.monitor != nil {
+				selectedName := m.getSelectedName()
+				var err error
+				if _, snoozed := m.monitor.SnoozedUntil(selectedName); snoozed {
+					err = m.monitor.UnsnoozeService(selectedName)
+					m.toast = fmt.Sprintf("✓ Notifications resumed for %s", selectedName)
+				} else {
+					until := time.Now().Add(snoozeDuration)
+					err = m.monitor.SnoozeService(selectedName, until)
+					m.toast = fmt.Sprintf("✓ Snoozed %s until %s", selectedName, until.Format("15:04"))
+				}
+				if err != nil {
+					m.toast = fmt.Sprintf("✗ Snooze not saved: %v", err)
+				}
+				m.toastTime = time.Now()
+			}
+		case key.Matches(msg, keys.Units):
+			// Cycle latency display unit
+			switch m.latencyUnit {
+			case latencyUnitAuto:
+				m.latencyUnit = latencyUnitMs
+			case latencyUnitMs:
+				m.latencyUnit = latencyUnitS
+			default:
+				m.latencyUnit = latencyUnitAuto
+			}
+		case key.Matches(msg, keys.Body):
+			// Show the last captured response for the selected service
+			if len(m.services) > 0 {
+				m.captureName = m.getSelectedName()
+				m.captureView = viewport.New(m.paneSize())
+				m.captureView.SetContent(m.renderCaptureContent(m.captureName))
+				m.showCapture = true
+			}
+		case key.Matches(msg, keys.ColumnsUp):
+			// Add a grid column, starting from the automatic count
+			cols, _ := m.gridLayout(m.width)
+			m.columns = cols + 1
+		case key.Matches(msg, keys.ColumnsDown):
+			cols, _ := m.gridLayout(m.width)
+			m.columns = max(cols-1, 1)
+		case key.Matches(msg, keys.Layout):
+			// Switch between the card grid and the compact list, remembering
+			// the choice in the config
+			m.listLayout = !m.listLayout
+			if m.monitor != nil {
+				layout := layoutCards
+				if m.listLayout {
+					layout = layoutList
+				}
+				m.monitor.UpdateDisplay(func(display *config.Display) { display.Layout = layout })
+				m.saveConfig()
+			}
+		case key.Matches(msg, keys.Events):
+			// Show recent status transitions across all services
+			m.eventsView = viewport.New(m.paneSize())
+			m.eventsView.SetContent(m.renderEventsContent())
+			m.showEvents = true
+		case key.Matches(msg, keys.Compare):
+			// Toggle the grouped comparison view
+			m.showCompare = !m.showCompare
+		case key.Matches(msg, keys.Copy):
 			// Copy curl command to clipboard
 			if len(m.services) > 0 {
 				selectedName := m.getSelectedName()
@@ -210,26 +334,51 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					return m, copyToClipboard(curlCmd)
 				}
 			}
-		case "left", "h":
-			m.moveSelection(-1)
-		case "right", "l":
-			m.moveSelection(1)
-		case "up", "k":
+		case key.Matches(msg, keys.ScrollDown):
+			m.scroll(1, msg.String() == "pgdown")
+		case key.Matches(msg, keys.ScrollUp):
+			m.scroll(-1, msg.String() == "pgup")
+		case key.Matches(msg, keys.Prev):
 			m.moveSelection(-1)
-		case "down", "j":
+		case key.Matches(msg, keys.Next):
 			m.moveSelection(1)
-		case "tab":
-			m.moveSelection(1)
-		case "shift+tab":
-			m.moveSelection(-1)
+		case key.Matches(msg, keys.Help):
+			m.showHelp = true
 		}
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
+		if m.showCapture {
+			m.captureView.Width, m.captureView.Height = m.paneSize()
+			m.captureView.SetContent(m.renderCaptureContent(m.captureName))
+		}
+		if m.showEvents {
+			m.eventsView.Width, m.eventsView.Height = m.paneSize()
+			m.eventsView.SetContent(m.renderEventsContent())
+		}
 
 	case resultMsg:
-		m.updateServiceState(monitor.Result(msg))
-		return m, waitForResults(m.monitor)
+		if m.shuttingDown {
+			return m, nil
+		}
+		spinnerCmd := m.updateServiceState(monitor.Result(msg))
+		if m.showCapture && msg.ServiceName == m.captureName && msg.Capture != nil {
+			m.captureView.SetContent(m.renderCaptureContent(m.captureName))
+		}
+		if m.showEvents && msg.Status != msg.PreviousStatus {
+			m.eventsView.SetContent(m.renderEventsContent())
+		}
+		return m, tea.Batch(waitForResults(m.monitor), spinnerCmd)
+
+	case monitorStoppedMsg:
+		// Stop listening; the dashboard keeps showing the last results
+		m.monitorStopped = true
+		return m, nil
+
+	case shutdownMsg:
+		m.quitting = true
+		m.shutdownErr = msg.Err
+		return m, tea.Quit
 
 	case spinner.TickMsg:
 		// Update all active spinners
@@ -244,36 +393,88 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, tea.Batch(cmds...)
 
 	case tickMsg:
-		// Only trigger render if clipboard message should be cleared
-		if m.clipboardMsg != "" && time.Since(m.clipboardTime) >= 3*time.Second {
-			m.clipboardMsg = ""
+		// Only trigger render if toast message should be cleared
+		if m.toast != "" && time.Since(m.toastTime) >= 3*time.Second {
+			m.toast = ""
 		}
 		return m, doTick()
 
 	case clipboardMsg:
-		m.clipboardMsg = msg.message
-		m.clipboardTime = time.Now()
+		m.toast = msg.message
+		m.toastTime = time.Now()
+		return m, nil
+
+	case ConfigReloadedMsg:
+		if msg.Err != nil {
+			m.toast = fmt.Sprintf("✗ Reload failed: %v", msg.Err)
+		} else {
+			m.pruneServices()
+			m.addPendingServices()
+			m.toast = fmt.Sprintf("✓ Config reloaded (%s)", msg.Summary)
+		}
+		m.toastTime = time.Now()
 		return m, nil
 	}
 
 	return m, nil
 }
 
+// saveConfig saves the config, showing a failure as a toast and marking the
+// config dirty so shutdown tries again
+func (m *Model) saveConfig() {
+	if err := m.monitor.SaveConfig(); err != nil {
+		m.configDirty = true
+		m.toast = fmt.Sprintf("✗ Failed to save config: %v", err)
+		m.toastTime = time.Now()
+		return
+	}
+	m.configDirty = false
+}
+
+// shutdown waits briefly for the monitor to stop, then persists unsaved config.
+// Paused services aren't part of it: pausing is a runtime-only toggle, and
+// service:disable is the persistent equivalent.
+func (m Model) shutdown() tea.Cmd {
+	mon := m.monitor
+	dirty := m.configDirty
+	return func() tea.Msg {
+		if mon == nil {
+			return shutdownMsg{}
+		}
+
+		select {
+		case <-mon.Done():
+		case <-time.After(shutdownTimeout):
+		}
+
+		if dirty {
+			if err := mon.SaveConfig(); err != nil {
+				return shutdownMsg{Err: err}
+			}
+		}
+
+		return shutdownMsg{}
+	}
+}
+
 // initAddServiceForm initializes the form for adding a new service
 func (m *Model) initAddServiceForm() {
 	m.formData = &FormData{
 		Method:         "GET",
 		ExpectedStatus: "200",
 		AuthType:       "bearer",
+		Confirmed:      true,
 	}
 	m.form = huh.NewForm(
 		huh.NewGroup(
 			huh.NewInput().
 				Title("Service Name").
-				Value(&m.formData.Name),
+				Value(&m.formData.Name).
+				Validate(m.validateServiceName),
 			huh.NewInput().
 				Title("Service URL").
-				Value(&m.formData.URL),
+				Value(&m.formData.URL).
+				Validate(validateServiceURL),
 			huh.NewInput().
 				Title("Health Endpoint (optional)").
 				Value(&m.formData.HealthEndpoint),
@@ -288,7 +489,8 @@ func (m *Model) initAddServiceForm() {
 				Value(&m.formData.Method),
 			huh.NewInput().
 				Title("Expected Status Code").
-				Value(&m.formData.ExpectedStatus),
+				Value(&m.formData.ExpectedStatus).
+				Validate(validateExpectedStatus),
 		).Title("Service Details (Esc to cancel)"),
 		huh.NewGroup(
 			huh.NewSelect[string]().
@@ -312,17 +514,37 @@ func (m *Model) initAddServiceForm() {
 		huh.NewGroup(
 			huh.NewInput().
 				Title("Custom Headers (key:value,key:value)").
-				Value(&m.formData.Headers),
+				Value(&m.formData.Headers).
+				Validate(validateHeadersFromTUI),
 			huh.NewInput().
 				Title("JSON Assertions (path:value:operator,...)").
-				Description("Example: status:ok:==,uptime:0:>").
-				Value(&m.formData.JSONAssertions),
+				Description(`Example: status:ok:==,uptime:0:>  (quote values with commas: msg:"a, b":==)`).
+				Value(&m.formData.JSONAssertions).
+				Validate(validateJSONAssertionsFromTUI),
 		).Title("Advanced (Optional)"),
+		huh.NewGroup(
+			huh.NewConfirm().
+				Title("Add this service?").
+				Affirmative("Add").
+				Negative("Cancel").
+				Value(&m.formData.Confirmed),
+		).Title("Confirm"),
 	).WithTheme(huh.ThemeCatppuccin()).WithWidth(80).WithShowHelp(true)
 }
 
-// updateServiceState updates or adds a service state based on a result
-func (m *Model) updateServiceState(result monitor.Result) {
+// updateServiceState updates or adds a service state based on a result,
+// returning a command to animate any newly started spinner
+func (m *Model) updateServiceState(result monitor.Result) tea.Cmd {
+	// A throttled check didn't run, so keep the last result and flag it
+	if result.Status == monitor.StatusThrottled {
+		for i := range m.services {
+			if m.services[i].Name == result.ServiceName {
+				m.services[i].RateLimited = true
+			}
+		}
+		return nil
+	}
+
 	// Find existing service or create new one
 	found := false
 	isChecking := result.Status == monitor.StatusChecking
@@ -336,16 +558,31 @@ func (m *Model) updateServiceState(result monitor.Result) {
 		if svc.Name == result.ServiceName {
 			// Preserve paused state
 			isPaused := m.pausedServices[result.ServiceName]
+			// Keep showing extracted values while rechecking so cards
+			// don't change height
+			fields := result.Fields
+			checkOutcomes := checkResults(checks, result)
+			if isChecking {
+				fields = svc.Fields
+				checkOutcomes = svc.CheckResults
+			}
 			m.services[i] = ServiceState{
 				Name:         result.ServiceName,
 				Status:       result.Status,
 				ResponseTime: result.ResponseTime,
+				AvgLatency:   result.AvgLatency,
+				Timing:       result.Details,
+				Endpoints:    result.Endpoints,
+				Assertions:   result.Assertions,
+				Fields:       fields,
 				Message:      result.Message,
+				Reason:       result.FailureReason,
 				LastChecked:  result.CheckedAt,
 				StatusCode:   result.StatusCode,
 				Error:        result.Error,
 				IsChecking:   isChecking,
 				Checks:       checks,
+				CheckResults: checkOutcomes,
 				Paused:       isPaused,
 			}
 			found = true
@@ -359,12 +596,19 @@ func (m *Model) updateServiceState(result monitor.Result) {
 			Name:         result.ServiceName,
 			Status:       result.Status,
 			ResponseTime: result.ResponseTime,
+			AvgLatency:   result.AvgLatency,
+			Timing:       result.Details,
+			Endpoints:    result.Endpoints,
+			Assertions:   result.Assertions,
+			Fields:       result.Fields,
 			Message:      result.Message,
+			Reason:       result.FailureReason,
 			LastChecked:  result.CheckedAt,
 			StatusCode:   result.StatusCode,
 			Error:        result.Error,
 			IsChecking:   isChecking,
 			Checks:       checks,
+			CheckResults: checkResults(checks, result),
 			Paused:       isPaused,
 		})
 		// Sort services by name for stable order
@@ -377,56 +621,253 @@ func (m *Model) updateServiceState(result monitor.Result) {
 	if isChecking {
 		// Create spinner if it doesn't exist
 		if _, exists := m.spinners[result.ServiceName]; !exists {
-			s := spinner.New()
-			s.Spinner = spinner.MiniDot
-			s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("#FFD700")) // Gold
+			s := m.newSpinner()
 			m.spinners[result.ServiceName] = s
+			return s.Tick
 		}
 	} else {
 		// Remove spinner when done checking
 		delete(m.spinners, result.ServiceName)
 	}
+	return nil
 }
 
-// parseHeadersFromTUI parses headers from TUI format (key:value,key:value)
-func parseHeadersFromTUI(headerStr string) map[string]string {
-	headers := make(map[string]string)
-	if headerStr == "" {
-		return headers
+// pruneServices drops services that are no longer configured or enabled
+func (m *Model) pruneServices() {
+	configured := make(map[string]bool)
+	for _, svc := range m.monitor.Services() {
+		if svc.IsEnabled() {
+			configured[svc.Name] = true
+		}
+	}
+
+	kept := m.services[:0]
+	for _, svc := range m.services {
+		if configured[svc.Name] {
+			kept = append(kept, svc)
+		} else {
+			delete(m.spinners, svc.Name)
+			delete(m.pausedServices, svc.Name)
+		}
+	}
+	m.services = kept
+	m.clampSelection()
+}
+
+// addPendingServices shows configured, enabled services that haven't
+// reported a result yet as pending, so they're listed before their first check
+func (m *Model) addPendingServices() {
+	if m.monitor == nil {
+		return
+	}
+
+	shown := make(map[string]bool, len(m.services))
+	for _, svc := range m.services {
+		shown[svc.Name] = true
+	}
+
+	added := false
+	for _, svc := range m.monitor.Services() {
+		if !svc.IsEnabled() || shown[svc.Name] {
+			continue
+		}
+		m.services = append(m.services, ServiceState{
+			Name:   svc.Name,
+			Status: monitor.StatusPending,
+			Checks: m.buildCheckLabels(svc),
+			Paused: m.pausedServices[svc.Name],
+		})
+		added = true
+	}
+	if added {
+		sort.Slice(m.services, func(i, j int) bool { return m.services[i].Name < m.services[j].Name })
 	}
+}
+
+// jsonOperators are the assertion operators supported by the HTTP checker
+var jsonOperators = map[string]bool{
+	"==": true, "!=": true, ">": true, "<": true, ">=": true, "<=": true,
+	"contains": true, "absent": true, "empty": true,
+}
 
-	pairs := strings.Split(headerStr, ",")
-	for _, pair := range pairs {
-		kv := strings.Split(strings.TrimSpace(pair), ":")
-		if len(kv) == 2 {
-			headers[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+// validateServiceName requires a name that isn't already in use
+func (m *Model) validateServiceName(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if m.monitor != nil {
+		if _, exists := m.monitor.ServiceConfig(name); exists {
+			return fmt.Errorf("service '%s' already exists", name)
+		}
+	}
+	return nil
+}
+
+// validateServiceURL requires an absolute http(s) URL
+func validateServiceURL(value string) error {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return fmt.Errorf("URL is required")
+	}
+	if strings.Contains(value, "${") {
+		// Placeholders are resolved at check time
+		return nil
+	}
+	u, err := url.Parse(value)
+	if err != nil {
+		return fmt.Errorf("invalid URL: %v", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("URL must start with http:// or https://")
+	}
+	if u.Host == "" {
+		return fmt.Errorf("URL must include a host")
+	}
+	return nil
+}
+
+// validateExpectedStatus requires an HTTP status code
+func validateExpectedStatus(value string) error {
+	status, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || status < 100 || status > 599 {
+		return fmt.Errorf("expected status must be a number between 100 and 599")
+	}
+	return nil
+}
+
+// validateHeadersFromTUI requires every header to be in key:value form
+func validateHeadersFromTUI(headerStr string) error {
+	for _, pair := range splitOutsideQuotes(headerStr, ',', -1) {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		if _, _, ok := splitHeaderFromTUI(pair); !ok {
+			return fmt.Errorf("invalid header %q, expected key:value", strings.TrimSpace(pair))
+		}
+	}
+	return nil
+}
+
+// validateJSONAssertionsFromTUI requires every assertion to be in
+// path:value:operator form with a supported operator
+func validateJSONAssertionsFromTUI(assertionStr string) error {
+	for _, pair := range splitOutsideQuotes(assertionStr, ',', -1) {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		_, _, operator, ok := splitAssertionFromTUI(pair)
+		if !ok {
+			return fmt.Errorf("invalid assertion %q, expected path:value:operator", strings.TrimSpace(pair))
+		}
+		if !jsonOperators[operator] {
+			return fmt.Errorf("unknown operator %q in assertion %q", operator, strings.TrimSpace(pair))
+		}
+	}
+	return nil
+}
+
+// parseHeadersFromTUI parses headers from TUI format (key:value,key:value).
+// Values may contain colons; wrap a key or value in double quotes to include commas.
+func parseHeadersFromTUI(headerStr string) map[string]string {
+	headers := make(map[string]string)
+	for _, pair := range splitOutsideQuotes(headerStr, ',', -1) {
+		if key, value, ok := splitHeaderFromTUI(pair); ok {
+			headers[key] = value
 		}
 	}
 	return headers
 }
 
-// parseJSONAssertionsFromTUI parses JSON assertions from TUI format (path:value:operator,...)
+// splitHeaderFromTUI splits a key:value header on its first unquoted colon
+func splitHeaderFromTUI(pair string) (string, string, bool) {
+	kv := splitOutsideQuotes(strings.TrimSpace(pair), ':', 2)
+	if len(kv) != 2 {
+		return "", "", false
+	}
+
+	key, _ := unquoteFromTUI(kv[0])
+	value, _ := unquoteFromTUI(kv[1])
+	if key == "" {
+		return "", "", false
+	}
+	return key, value, true
+}
+
+// parseJSONAssertionsFromTUI parses JSON assertions from TUI format (path:value:operator,...).
+// Values may contain colons; wrap a path or value in double quotes to include
+// colons in paths or commas, or to keep a value like "200" as a string.
 func parseJSONAssertionsFromTUI(assertionStr string) []config.JSONAssertion {
 	var assertions []config.JSONAssertion
-	if assertionStr == "" {
-		return assertions
-	}
-
-	pairs := strings.Split(assertionStr, ",")
-	for _, pair := range pairs {
-		parts := strings.Split(strings.TrimSpace(pair), ":")
-		if len(parts) >= 3 {
-			assertion := config.JSONAssertion{
-				Path:     parts[0],
-				Value:    parseJSONValueFromTUI(parts[1]),
-				Operator: parts[2],
-			}
-			assertions = append(assertions, assertion)
+	for _, pair := range splitOutsideQuotes(assertionStr, ',', -1) {
+		path, value, operator, ok := splitAssertionFromTUI(pair)
+		if !ok {
+			continue
+		}
+
+		assertion := config.JSONAssertion{
+			Path:     path,
+			Operator: operator,
 		}
+		if unquoted, quoted := unquoteFromTUI(value); quoted {
+			assertion.Value = unquoted
+		} else {
+			assertion.Value = parseJSONValueFromTUI(value)
+		}
+		assertions = append(assertions, assertion)
 	}
 	return assertions
 }
 
+// splitAssertionFromTUI splits path:value:operator, taking the path up to the
+// first unquoted colon and the operator after the last so values keep colons
+func splitAssertionFromTUI(pair string) (path, value, operator string, ok bool) {
+	parts := splitOutsideQuotes(strings.TrimSpace(pair), ':', -1)
+	if len(parts) < 3 {
+		return "", "", "", false
+	}
+
+	path, _ = unquoteFromTUI(parts[0])
+	value = strings.TrimSpace(strings.Join(parts[1:len(parts)-1], ":"))
+	operator = strings.TrimSpace(parts[len(parts)-1])
+	if path == "" {
+		return "", "", "", false
+	}
+	return path, value, operator, true
+}
+
+// splitOutsideQuotes splits s on sep, ignoring separators inside double
+// quotes, into at most n parts (n < 0 means no limit)
+func splitOutsideQuotes(s string, sep byte, n int) []string {
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+
+	var parts []string
+	inQuotes := false
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '"':
+			inQuotes = !inQuotes
+		case s[i] == sep && !inQuotes && (n < 0 || len(parts) < n-1):
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// unquoteFromTUI trims a field and removes surrounding double quotes,
+// reporting whether it was quoted
+func unquoteFromTUI(s string) (string, bool) {
+	s = strings.TrimSpace(s)
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		return s[1 : len(s)-1], true
+	}
+	return s, false
+}
+
 // parseJSONValueFromTUI attempts to parse a string into a JSON-compatible value
 func parseJSONValueFromTUI(s string) interface{} {
 	s = strings.TrimSpace(s)
@@ -449,13 +890,11 @@ func parseJSONValueFromTUI(s string) interface{} {
 
 // getServiceConfig returns the config for a service name
 func (m *Model) getServiceConfig(name string) *config.Service {
-	if m.monitor == nil || m.monitor.Config == nil {
+	if m.monitor == nil {
 		return nil
 	}
-	for i := range m.monitor.Config.Services {
-		if m.monitor.Config.Services[i].Name == name {
-			return &m.monitor.Config.Services[i]
-		}
+	if svc, ok := m.monitor.ServiceConfig(name); ok {
+		return &svc
 	}
 	return nil
 }
@@ -464,8 +903,12 @@ func (m *Model) getServiceConfig(name string) *config.Service {
 func (m *Model) buildCheckLabels(svc config.Service) []string {
 	labels := []string{}
 
-	// Base type
-	switch strings.ToLower(svc.Type) {
+	// Base type, as the monitor will actually check it
+	checkerType := svc.CheckerType()
+	if m.monitor != nil {
+		checkerType = m.monitor.CheckerType(svc)
+	}
+	switch strings.ToLower(checkerType) {
 	case "tcp":
 		labels = append(labels, "TCP")
 	case "tls":
@@ -474,6 +917,12 @@ func (m *Model) buildCheckLabels(svc config.Service) []string {
 		labels = append(labels, "DNS")
 	case "latency":
 		labels = append(labels, "Latency")
+	case "download":
+		labels = append(labels, "Download")
+	case "reachable":
+		labels = append(labels, "Reachable")
+	case "command":
+		labels = append(labels, "Command")
 	default:
 		labels = append(labels, "HTTP")
 	}
@@ -502,6 +951,31 @@ func (m *Model) buildCheckLabels(svc config.Service) []string {
 	return dedupe(labels)
 }
 
+// checkResults attributes a result to a service's check labels so each can
+// be colored by its own outcome. The base check fails unless the result only
+// failed its JSON assertions, and JSON reflects the assertions; labels for
+// checks that didn't run are left out.
+func checkResults(checks []string, result monitor.Result) map[string]bool {
+	if len(checks) == 0 || (result.Status != monitor.StatusHealthy && result.Status != monitor.StatusUnhealthy) {
+		return nil
+	}
+
+	assertionsPassed := true
+	for _, assertion := range result.Assertions {
+		if !assertion.Passed {
+			assertionsPassed = false
+		}
+	}
+
+	results := map[string]bool{
+		checks[0]: result.Status == monitor.StatusHealthy || (result.FailureReason == monitor.ReasonAssertion && !assertionsPassed),
+	}
+	if len(result.Assertions) > 0 && slices.Contains(checks, "JSON") {
+		results["JSON"] = assertionsPassed
+	}
+	return results
+}
+
 // dedupe removes duplicates while preserving order
 func dedupe(items []string) []string {
 	seen := make(map[string]bool)
@@ -524,6 +998,74 @@ func (m *Model) moveSelection(delta int) {
 	if m.selectedIndex < 0 {
 		m.selectedIndex += len(m.services)
 	}
+	m.scrollToSelected()
+}
+
+// scroll moves the dashboard body by a card row (a line outside the grid),
+// or by a whole page
+func (m *Model) scroll(delta int, page bool) {
+	width := m.viewWidth()
+	lines, rows := m.dashboardBody(width)
+	height := m.bodyHeight(width, len(lines))
+	if height == 0 || len(lines) <= height {
+		m.scrollOffset = 0
+		return
+	}
+
+	offset := clampScroll(m.scrollOffset, len(lines), height)
+	switch {
+	case page:
+		offset += delta * height
+	case len(rows) > 0:
+		offset = nextRowStart(rows, offset, delta)
+	default:
+		offset += delta
+	}
+	m.scrollOffset = clampScroll(offset, len(lines), height)
+}
+
+// nextRowStart returns the start of the card row after (delta > 0) or
+// before the given offset
+func nextRowStart(rows []layoutRow, offset, delta int) int {
+	if delta > 0 {
+		for _, row := range rows {
+			if row.start > offset {
+				return row.start
+			}
+		}
+		return offset + delta
+	}
+
+	for i := len(rows) - 1; i >= 0; i-- {
+		if rows[i].start < offset {
+			return rows[i].start
+		}
+	}
+	return 0
+}
+
+// scrollToSelected scrolls just enough to keep the selected card visible
+func (m *Model) scrollToSelected() {
+	width := m.viewWidth()
+	lines, rows := m.dashboardBody(width)
+	height := m.bodyHeight(width, len(lines))
+	if height == 0 || len(lines) <= height {
+		m.scrollOffset = 0
+		return
+	}
+
+	offset := clampScroll(m.scrollOffset, len(lines), height)
+	for _, row := range rows {
+		if !row.selected {
+			continue
+		}
+		if row.start < offset {
+			offset = row.start
+		} else if row.end > offset+height {
+			offset = row.end - height
+		}
+	}
+	m.scrollOffset = clampScroll(offset, len(lines), height)
 }
 
 // getSelectedName returns the currently selected service name
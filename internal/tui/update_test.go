@@ -0,0 +1,179 @@
+package tui
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/juststeveking/scout/internal/config"
+	"github.com/juststeveking/scout/internal/monitor"
+)
+
+func TestParseHeadersFromTUI(t *testing.T) {
+	headers := parseHeadersFromTUI(`Authorization:Bearer x:y, X-Callback: http://example.com/hook, Accept:"text/html, application/json"`)
+
+	expected := map[string]string{
+		"Authorization": "Bearer x:y",
+		"X-Callback":    "http://example.com/hook",
+		"Accept":        "text/html, application/json",
+	}
+	if !reflect.DeepEqual(headers, expected) {
+		t.Errorf("Expected %v, got %v", expected, headers)
+	}
+
+	if err := validateHeadersFromTUI("Authorization:Bearer x:y"); err != nil {
+		t.Errorf("Expected header with colons to be valid, got %v", err)
+	}
+	if err := validateHeadersFromTUI("no-separator"); err == nil {
+		t.Error("Expected header without a separator to be invalid")
+	}
+}
+
+func TestParseJSONAssertionsFromTUI(t *testing.T) {
+	assertions := parseJSONAssertionsFromTUI(`status:ok:==, links.self:http://example.com:8080/health:==, "a.b:c":true:==, message:"degraded, retrying":contains, code:"200":==, uptime:0:>`)
+
+	expected := []config.JSONAssertion{
+		{Path: "status", Value: "ok", Operator: "=="},
+		{Path: "links.self", Value: "http://example.com:8080/health", Operator: "=="},
+		{Path: "a.b:c", Value: true, Operator: "=="},
+		{Path: "message", Value: "degraded, retrying", Operator: "contains"},
+		{Path: "code", Value: "200", Operator: "=="},
+		{Path: "uptime", Value: float64(0), Operator: ">"},
+	}
+	if !reflect.DeepEqual(assertions, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, assertions)
+	}
+
+	if err := validateJSONAssertionsFromTUI(`links.self:http://example.com:==`); err != nil {
+		t.Errorf("Expected assertion with colons in the value to be valid, got %v", err)
+	}
+	if err := validateJSONAssertionsFromTUI("status:ok:=~"); err == nil {
+		t.Error("Expected unknown operator to be invalid")
+	}
+	if err := validateJSONAssertionsFromTUI("status:ok"); err == nil {
+		t.Error("Expected assertion without operator to be invalid")
+	}
+}
+
+func TestWaitForResultsAfterMonitorStops(t *testing.T) {
+	mon, err := monitor.NewMonitor(&config.Config{CheckInterval: "30s", Timeout: "1s"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	mon.Start(ctx)
+
+	select {
+	case <-mon.Done():
+	case <-time.After(time.Second):
+		t.Fatal("Expected the monitor to stop")
+	}
+
+	msg := waitForResults(mon)()
+	if _, ok := msg.(monitorStoppedMsg); !ok {
+		t.Fatalf("Expected monitorStoppedMsg from a closed results channel, got %T", msg)
+	}
+
+	updated, cmd := NewModel(mon, cancel).Update(msg)
+	m := updated.(Model)
+	if !m.monitorStopped {
+		t.Error("Expected the model to record that the monitor stopped")
+	}
+	if cmd != nil {
+		t.Error("Expected no further command listening for results")
+	}
+	if len(m.services) != 0 {
+		t.Errorf("Expected no services from a closed channel, got %+v", m.services)
+	}
+}
+
+func TestCheckResults(t *testing.T) {
+	checks := []string{"HTTP", "TLS", "JSON"}
+
+	// Failing only the JSON assertions leaves the HTTP check passing, and
+	// checks that didn't run have no outcome
+	results := checkResults(checks, monitor.Result{
+		Status:        monitor.StatusUnhealthy,
+		FailureReason: monitor.ReasonAssertion,
+		Assertions:    []monitor.AssertionResult{{Passed: true}, {Passed: false}},
+	})
+	expected := map[string]bool{"HTTP": true, "JSON": false}
+	if !reflect.DeepEqual(results, expected) {
+		t.Errorf("Expected %v, got %v", expected, results)
+	}
+
+	// A connection failure fails the base check before assertions run
+	results = checkResults(checks, monitor.Result{Status: monitor.StatusUnhealthy, FailureReason: monitor.ReasonConnect})
+	expected = map[string]bool{"HTTP": false}
+	if !reflect.DeepEqual(results, expected) {
+		t.Errorf("Expected %v, got %v", expected, results)
+	}
+
+	if results := checkResults(checks, monitor.Result{Status: monitor.StatusChecking}); results != nil {
+		t.Errorf("Expected no outcomes while checking, got %v", results)
+	}
+}
+
+func TestShutdownAfterMonitorCancel(t *testing.T) {
+	mon, err := monitor.NewMonitor(&config.Config{CheckInterval: "30s", Timeout: "1s"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go mon.Start(ctx)
+
+	// Quitting cancels the monitor and waits for it to stop
+	updated, cmd := NewModel(mon, cancel).Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	if !updated.(Model).shuttingDown || cmd == nil {
+		t.Fatal("Expected quit to start shutting down")
+	}
+
+	msgs := make(chan tea.Msg, 1)
+	go func() { msgs <- cmd() }()
+
+	select {
+	case msg := <-msgs:
+		shutdown, ok := msg.(shutdownMsg)
+		if !ok {
+			t.Fatalf("Expected shutdownMsg, got %T", msg)
+		}
+		if shutdown.Err != nil {
+			t.Errorf("Expected a clean shutdown, got %v", shutdown.Err)
+		}
+	case <-time.After(shutdownTimeout):
+		t.Fatal("Expected shutdownMsg within shutdownTimeout")
+	}
+
+	select {
+	case <-mon.Done():
+	default:
+		t.Error("Expected the monitor to have stopped before shutdownMsg")
+	}
+}
+
+func TestBuildCheckLabelsUsesCheckerType(t *testing.T) {
+	mon, err := monitor.NewMonitor(&config.Config{Timeout: "1s"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mon.Close()
+	m := NewModel(mon, func() {})
+
+	// A bare host:port is checked, and so labelled, as TCP
+	labels := m.buildCheckLabels(config.Service{Name: "db", URL: "db.internal:5432"})
+	if len(labels) == 0 || labels[0] != "TCP" {
+		t.Errorf("Expected inferred tcp service to be labelled TCP, got %v", labels)
+	}
+
+	// lenient_status checks HTTP services for reachability instead
+	mon.Config.LenientStatus = true
+	labels = m.buildCheckLabels(config.Service{Name: "api", URL: "https://api.example.com"})
+	if len(labels) == 0 || labels[0] != "Reachable" {
+		t.Errorf("Expected lenient http service to be labelled Reachable, got %v", labels)
+	}
+}
@@ -3,6 +3,7 @@ package tui
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -10,79 +11,22 @@ import (
 	"github.com/juststeveking/scout/internal/monitor"
 )
 
-var (
-	colorAccent    = lipgloss.Color("#7dcfff") // Softer Cyan
-	colorHealthy   = lipgloss.Color("#9ece6a") // Soft Green
-	colorUnhealthy = lipgloss.Color("#f7768e") // Soft Red
-	colorChecking  = lipgloss.Color("#e0af68") // Warm Yellow
-	colorPaused    = lipgloss.Color("#565f89") // Muted Blue for paused
-	colorMuted     = lipgloss.Color("#565f89") // Muted Blue
-	colorSubtle    = lipgloss.Color("#414868") // Lighter subtle
-	colorCard      = lipgloss.Color("#1a1b26") // Softer dark background
-	colorText      = lipgloss.Color("#c0caf5") // Light Blue/White
-
-	// Title style
-	titleStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(colorAccent).
-			MarginBottom(1)
-
-	// Subtitle/header style
-	headerStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(colorAccent).
-			MarginTop(1).
-			MarginBottom(1)
-
-	// Status indicators
-	healthyStyle = lipgloss.NewStyle().
-			Foreground(colorHealthy).
-			Bold(true)
-
-	unhealthyStyle = lipgloss.NewStyle().
-			Foreground(colorUnhealthy).
-			Bold(true)
-
-	checkingStyle = lipgloss.NewStyle().
-			Foreground(colorChecking).
-			Bold(true)
-
-	pausedStyle = lipgloss.NewStyle().
-			Foreground(colorPaused).
-			Bold(true)
-
-	// Base card style (border color will be overridden)
-	baseCardStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			Background(colorCard).
-			Padding(0, 1).
-			MarginRight(1).
-			MarginBottom(1)
-
-	// Metadata style
-	metadataStyle = lipgloss.NewStyle().
-			Foreground(colorMuted)
-
-	// Error style
-	errorStyle = lipgloss.NewStyle().
-			Foreground(colorUnhealthy)
-
-	// Service name style for grid
-	serviceNameStyle = lipgloss.NewStyle().
-				Bold(true).
-				Foreground(colorText)
-
-	// Secondary info style
-	secondaryStyle = lipgloss.NewStyle().
-			Foreground(colorMuted)
-)
-
 // View renders the TUI with full-screen grid layout
 func (m Model) View() string {
 	if m.quitting {
 		return ""
 	}
 
+	if m.shuttingDown {
+		return lipgloss.Place(
+			m.width,
+			m.height,
+			lipgloss.Center,
+			lipgloss.Center,
+			metadataStyle.Render("Shutting down... (press q again to force quit)"),
+		)
+	}
+
 	// Render form if active
 	if m.showForm {
 		return lipgloss.Place(
@@ -103,30 +47,27 @@ func (m Model) View() string {
 		return m.renderErrorDetailOverlay()
 	}
 
+	// Render event feed if active
+	if m.showEvents {
+		return m.renderEventsOverlay()
+	}
+
+	// Render capture pane if active
+	if m.showCapture {
+		return m.renderCaptureOverlay()
+	}
+
 	// Render detail modal if active
 	if m.showDetail {
 		return m.renderDetailOverlay()
 	}
 
-	// Handle initial state when width is not set
-	width := m.width
-	if width < 40 {
-		width = 80
+	// Render help overlay if active
+	if m.showHelp {
+		return m.renderHelpOverlay()
 	}
 
-	// Calculate grid dimensions
-	cols := 2
-	if width > 160 {
-		cols = 3
-	}
-	if width > 200 {
-		cols = 4
-	}
-	cardWidth := (width - 4) / cols
-	if cardWidth < 20 {
-		cardWidth = 20
-		cols = 1
-	}
+	width := m.viewWidth()
 
 	var b strings.Builder
 
@@ -135,64 +76,202 @@ func (m Model) View() string {
 	b.WriteString(headerContent)
 	b.WriteString("\n")
 
-	// Services or loading state
+	// Services, scrolled to the visible window when they overflow
+	lines, rows := m.dashboardBody(width)
+	indicator := ""
+	if height := m.bodyHeight(width, len(lines)); height > 0 && len(lines) > height {
+		offset := clampScroll(m.scrollOffset, len(lines), height)
+		indicator = scrollIndicator(rows, offset, height, len(lines))
+		lines = lines[offset : offset+height]
+	}
+	b.WriteString(strings.Join(lines, "\n"))
+
+	// Footer with summary and help
+	b.WriteString("\n")
+	b.WriteString(m.renderFooter(width, indicator))
+	b.WriteString("\n")
+
+	return b.String()
+}
+
+// viewWidth returns the terminal width, assuming 80 columns until it's known
+func (m Model) viewWidth() int {
+	if m.width < 40 {
+		return 80
+	}
+	return m.width
+}
+
+// layoutRow records where a row of service cards lands in the dashboard body
+type layoutRow struct {
+	start, end  int // Line range, end exclusive, including any group heading above
+	first, last int // 1-based positions of the row's first and last service
+	selected    bool
+}
+
+// dashboardBody renders the lines between the header and footer, along with
+// the card rows among them when showing the service grid
+func (m Model) dashboardBody(width int) ([]string, []layoutRow) {
 	if len(m.services) == 0 {
-		b.WriteString("\n")
 		centerText := "⟳ Waiting for health checks..."
-		padding := (width - len(centerText)) / 2
-		if padding > 0 {
-			b.WriteString(strings.Repeat(" ", padding))
-		}
-		b.WriteString(metadataStyle.Render(centerText))
-		b.WriteString("\n")
-	} else {
-		// Group services by status
-		healthy := []ServiceState{}
-		unhealthy := []ServiceState{}
-		checking := []ServiceState{}
+		padding := max((width-len(centerText))/2, 0)
+		return []string{"", strings.Repeat(" ", padding) + metadataStyle.Render(centerText), ""}, nil
+	}
 
-		for _, svc := range m.services {
-			if svc.IsChecking {
-				checking = append(checking, svc)
-			} else if svc.Status == monitor.StatusHealthy {
-				healthy = append(healthy, svc)
-			} else {
-				unhealthy = append(unhealthy, svc)
-			}
+	if m.showCompare {
+		return strings.Split(m.renderComparison(width), "\n"), nil
+	}
+
+	cols, cardWidth := m.gridLayout(width)
+	if m.listLayout {
+		cols = 1
+	}
+
+	// Group services by status
+	healthy := []ServiceState{}
+	unhealthy := []ServiceState{}
+	checking := []ServiceState{}
+	pending := []ServiceState{}
+
+	for _, svc := range m.services {
+		if svc.IsChecking {
+			checking = append(checking, svc)
+		} else if svc.Status == monitor.StatusHealthy {
+			healthy = append(healthy, svc)
+		} else if svc.Status == monitor.StatusPending {
+			pending = append(pending, svc)
+		} else {
+			unhealthy = append(unhealthy, svc)
 		}
+	}
 
-		// Sort each group by name for stable positioning
-		sort.Slice(checking, func(i, j int) bool { return checking[i].Name < checking[j].Name })
-		sort.Slice(healthy, func(i, j int) bool { return healthy[i].Name < healthy[j].Name })
-		sort.Slice(unhealthy, func(i, j int) bool { return unhealthy[i].Name < unhealthy[j].Name })
+	// Sort each group by priority then name for stable positioning
+	m.sortServices(checking)
+	m.sortServices(healthy)
+	m.sortServices(unhealthy)
+	m.sortServices(pending)
+
+	var lines []string
+	var rows []layoutRow
+	position := 0
+	selected := m.getSelectedName()
+	nameWidth := m.listNameWidth(width)
+
+	addGroup := func(title string, services []ServiceState) {
+		if len(services) == 0 {
+			return
+		}
 
-		// Render checking services in grid
-		selected := m.getSelectedName()
-		if len(checking) > 0 {
-			b.WriteString("\n" + headerStyle.Render("⟳ Checking ("+fmt.Sprintf("%d", len(checking))+")") + "\n")
-			b.WriteString(m.renderServiceGrid(checking, cardWidth, cols, selected))
+		groupStart := len(lines)
+		if len(lines) == 0 {
+			lines = append(lines, "")
 		}
+		lines = append(lines, headerStyle.Render(fmt.Sprintf("%s (%d)", title, len(services))))
+
+		for i := 0; i < len(services); i += cols {
+			end := min(i+cols, len(services))
+
+			row := layoutRow{start: len(lines), first: position + 1}
+			if i == 0 {
+				row.start = groupStart
+			}
+
+			var rowCards []string
+			for _, svc := range services[i:end] {
+				isSelected := svc.Name == selected
+				row.selected = row.selected || isSelected
+				if m.listLayout {
+					rowCards = append(rowCards, m.renderServiceRow(svc, width, nameWidth, isSelected))
+				} else {
+					rowCards = append(rowCards, m.renderServiceCompact(svc, cardWidth, isSelected))
+				}
+			}
+			position += end - i
 
-		// Render healthy services in grid
-		if len(healthy) > 0 {
-			b.WriteString("\n" + headerStyle.Render("✓ Healthy ("+fmt.Sprintf("%d", len(healthy))+")") + "\n")
-			b.WriteString(m.renderServiceGrid(healthy, cardWidth, cols, selected))
+			// Join cards horizontally and add to rows
+			lines = append(lines, strings.Split(lipgloss.JoinHorizontal(lipgloss.Top, rowCards...), "\n")...)
+			row.end = len(lines)
+			row.last = position
+			rows = append(rows, row)
 		}
+	}
 
-		// Render unhealthy services in grid
-		if len(unhealthy) > 0 {
-			b.WriteString("\n" + headerStyle.Render("✗ Unhealthy ("+fmt.Sprintf("%d", len(unhealthy))+")") + "\n")
-			b.WriteString(m.renderServiceGrid(unhealthy, cardWidth, cols, selected))
+	// Aggregate health for groups with a quorum configured
+	if m.monitor != nil {
+		if groups := m.monitor.Groups(); len(groups) > 0 {
+			lines = append(lines, "", headerStyle.Render(fmt.Sprintf("◆ Groups (%d)", len(groups))))
+			if m.listLayout {
+				for _, group := range groups {
+					lines = append(lines, m.renderGroupRow(group, nameWidth))
+				}
+			}
+			for i := 0; i < len(groups) && !m.listLayout; i += cols {
+				var rowCards []string
+				for _, group := range groups[i:min(i+cols, len(groups))] {
+					rowCards = append(rowCards, m.renderGroupCard(group, cardWidth))
+				}
+				lines = append(lines, strings.Split(lipgloss.JoinHorizontal(lipgloss.Top, rowCards...), "\n")...)
+			}
 		}
 	}
 
-	// Footer with summary and help
-	b.WriteString("\n")
+	addGroup("⟳ Checking", checking)
+	addGroup("✓ Healthy", healthy)
+	addGroup("✗ Unhealthy", unhealthy)
+	addGroup("◌ Pending", pending)
+
+	return lines, rows
+}
+
+// bodyHeight returns how many body lines fit between the header and footer,
+// or 0 when the terminal height isn't known and nothing is scrolled
+func (m Model) bodyHeight(width, totalLines int) int {
+	if m.height <= 0 {
+		return 0
+	}
+
+	// Measure the footer with the widest indicator it could show
+	header := m.renderHeader(width, len(m.services))
+	footer := m.renderFooter(width, fmt.Sprintf("Showing %d–%d of %d", totalLines, totalLines, totalLines))
+	return max(m.height-lipgloss.Height(header)-lipgloss.Height(footer)-1, 1)
+}
+
+// clampScroll bounds a scroll offset so the window stays within the body
+func clampScroll(offset, totalLines, height int) int {
+	return min(max(offset, 0), max(totalLines-height, 0))
+}
+
+// scrollIndicator describes the visible window, in services when the body
+// is the service grid and in lines otherwise
+func scrollIndicator(rows []layoutRow, offset, height, totalLines int) string {
+	if len(rows) == 0 {
+		return fmt.Sprintf("Lines %d–%d of %d", offset+1, min(offset+height, totalLines), totalLines)
+	}
 
+	first, last := 0, 0
+	for _, row := range rows {
+		if row.end > offset && row.start < offset+height {
+			if first == 0 {
+				first = row.first
+			}
+			last = row.last
+		}
+	}
+	return fmt.Sprintf("Showing %d–%d of %d", first, last, rows[len(rows)-1].last)
+}
+
+// renderFooter renders the status bar, appending the scroll indicator (if
+// any) to the health summary
+func (m Model) renderFooter(width int, indicator string) string {
 	// Create a status bar style footer
 	// [Last checked] [Help] [Status]
 
-	helpStr := "Quit: q   New: n   Pause: p   Error: e   Copy curl: c   Detail: Enter"
+	shortHelp := keys.ShortHelp()
+	helpParts := make([]string, len(shortHelp))
+	for i, binding := range shortHelp {
+		helpParts[i] = binding.Help().Key + " " + binding.Help().Desc
+	}
+	helpStr := strings.Join(helpParts, "   ")
 
 	// Status summary and last checked indicator
 	var statusSummary string
@@ -214,19 +293,29 @@ func (m Model) View() string {
 	} else {
 		statusSummary = "No services"
 	}
+	if indicator != "" {
+		statusSummary += " · " + indicator
+	}
 
 	lastCheckedText := "Last checked: --"
 	if !lastChecked.IsZero() {
 		lastCheckedText = fmt.Sprintf("Last checked: %s", m.formatTime(lastChecked))
 	}
+	if m.monitorStopped && !m.shuttingDown {
+		lastCheckedText = unhealthyStyle.Render("Monitoring stopped") + " · " + lastCheckedText
+	}
 
-	// Show clipboard message if recent
-	if m.clipboardMsg != "" && time.Since(m.clipboardTime) < 3*time.Second {
-		lastCheckedText = lipgloss.NewStyle().Foreground(colorHealthy).Render(m.clipboardMsg)
+	// Show toast message if recent
+	if m.toast != "" && time.Since(m.toastTime) < 3*time.Second {
+		toastColor := colorHealthy
+		if strings.HasPrefix(m.toast, "✗") {
+			toastColor = colorUnhealthy
+		}
+		lastCheckedText = lipgloss.NewStyle().Foreground(toastColor).Render(m.toast)
 	}
 
 	// Footer layout
-	// Last checked: 12 seconds ago      Quit: q   New: n   Pause: p   Error: e   Copy curl: c   Detail: Enter      5/10 Healthy
+	// Last checked: 12 seconds ago      Quit: q   New: n   ...   Detail: Enter      5/10 Healthy · Showing 1–12 of 40
 
 	footerStyle := lipgloss.NewStyle().
 		Foreground(colorMuted).
@@ -247,10 +336,48 @@ func (m Model) View() string {
 	padRight := gap - padLeft
 
 	footerContent := left + strings.Repeat(" ", padLeft) + middle + strings.Repeat(" ", padRight) + right
-	b.WriteString(footerStyle.Render(footerContent))
-	b.WriteString("\n")
+	return footerStyle.Render(footerContent)
+}
 
-	return b.String()
+// sortServices orders services by descending priority, then by name
+func (m Model) sortServices(services []ServiceState) {
+	priorities := make(map[string]int, len(services))
+	for _, svc := range services {
+		if cfg := m.getServiceConfig(svc.Name); cfg != nil {
+			priorities[svc.Name] = cfg.Priority
+		}
+	}
+
+	sort.Slice(services, func(i, j int) bool {
+		if priorities[services[i].Name] != priorities[services[j].Name] {
+			return priorities[services[i].Name] > priorities[services[j].Name]
+		}
+		return services[i].Name < services[j].Name
+	})
+}
+
+// minCardWidth is the narrowest readable service card
+const minCardWidth = 20
+
+// gridLayout returns the column count and card width for the service grid,
+// honoring a configured column count within what the width allows. The card
+// width is the same content width at every density, with roomier densities'
+// extra padding and margins taken out of it so the row still fits.
+func (m Model) gridLayout(width int) (int, int) {
+	cols := m.columns
+	if cols < 1 {
+		cols = 2
+		if width > 160 {
+			cols = 3
+		}
+		if width > 200 {
+			cols = 4
+		}
+	}
+
+	delta := m.density.frameDelta()
+	cols = min(cols, max((width-4)/(minCardWidth+delta), 1))
+	return cols, max((width-4)/cols-delta, minCardWidth)
 }
 
 // renderHeader renders an enhanced header with stats and visual appeal
@@ -261,11 +388,14 @@ func (m Model) renderHeader(width int, totalServices int) string {
 	healthy := 0
 	unhealthy := 0
 	checking := 0
+	pending := 0
 	for _, svc := range m.services {
 		if svc.IsChecking {
 			checking++
 		} else if svc.Status == monitor.StatusHealthy {
 			healthy++
+		} else if svc.Status == monitor.StatusPending {
+			pending++
 		} else {
 			unhealthy++
 		}
@@ -282,6 +412,55 @@ func (m Model) renderHeader(width int, totalServices int) string {
 		unhealthyIndicator := unhealthyStyle.Render(fmt.Sprintf("● %d", unhealthy))
 		checkingIndicator := checkingStyle.Render(fmt.Sprintf("● %d", checking))
 		stats = fmt.Sprintf("%s  %s  %s", healthyIndicator, unhealthyIndicator, checkingIndicator)
+		if pending > 0 {
+			stats += "  " + secondaryStyle.Render(fmt.Sprintf("◌ %d", pending))
+		}
+	}
+
+	// Overall availability across the retained history
+	if m.monitor != nil {
+		if availability, ok := m.monitor.Availability(); ok {
+			availabilityStyle := healthyStyle
+			if availability < 99 {
+				availabilityStyle = unhealthyStyle
+			}
+			indicator := secondaryStyle.Render("Availability: ") + availabilityStyle.Render(fmt.Sprintf("%.1f%%", availability))
+			if stats != "" {
+				stats = indicator + "  " + stats
+			} else {
+				stats = indicator
+			}
+		}
+	}
+
+	// Effective check rate, e.g. "~12 checks/min • 8 endpoints"
+	if m.monitor != nil && m.showCheckRate {
+		perMinute, endpoints := m.monitor.CheckRate()
+		format := "~%.0f checks/min"
+		if perMinute < 10 && perMinute != float64(int(perMinute)) {
+			// Slow rates keep a decimal so they don't round to zero
+			format = "~%.1f checks/min"
+		}
+		rate := fmt.Sprintf(format, perMinute)
+		if endpoints > 0 {
+			rate += fmt.Sprintf(" • %d endpoints", endpoints)
+		}
+		indicator := secondaryStyle.Render(rate)
+		if stats != "" {
+			stats = indicator + "  " + stats
+		} else {
+			stats = indicator
+		}
+	}
+
+	// Muted indicator
+	if m.monitor != nil && !m.monitor.NotificationsEnabled() {
+		muted := pausedStyle.Render("🔕 muted")
+		if stats != "" {
+			stats = muted + "  " + stats
+		} else {
+			stats = muted
+		}
 	}
 
 	// Layout: SCOUT on left, stats on right, vertically aligned
@@ -305,38 +484,8 @@ func (m Model) renderHeader(width int, totalServices int) string {
 	return b.String()
 }
 
-// renderServiceGrid renders services in a grid layout
-func (m Model) renderServiceGrid(services []ServiceState, cardWidth int, cols int, selectedName string) string {
-	if cardWidth < 20 {
-		cardWidth = 20
-	}
-
-	var rows []string
-	for i := 0; i < len(services); i += cols {
-		end := i + cols
-		if end > len(services) {
-			end = len(services)
-		}
-
-		var rowCards []string
-		for j := i; j < end; j++ {
-			isSelected := services[j].Name == selectedName
-			card := m.renderServiceCompact(services[j], cardWidth, isSelected)
-			rowCards = append(rowCards, card)
-		}
-
-		// Join cards horizontally and add to rows
-		row := lipgloss.JoinHorizontal(lipgloss.Top, rowCards...)
-		rows = append(rows, row)
-	}
-
-	return strings.Join(rows, "\n")
-}
-
 // renderServiceCompact renders a service card for grid layout with modern design
 func (m Model) renderServiceCompact(svc ServiceState, width int, isSelected bool) string {
-	var b strings.Builder
-
 	// Determine border color based on status
 	var borderColor lipgloss.Color
 	if svc.Paused {
@@ -347,7 +496,7 @@ func (m Model) renderServiceCompact(svc ServiceState, width int, isSelected bool
 			borderColor = colorHealthy
 		case monitor.StatusUnhealthy:
 			borderColor = colorUnhealthy
-		case monitor.StatusChecking:
+		case monitor.StatusChecking, monitor.StatusStalled:
 			borderColor = colorChecking
 		default:
 			borderColor = colorSubtle
@@ -371,9 +520,21 @@ func (m Model) renderServiceCompact(svc ServiceState, width int, isSelected bool
 		statusIcon = m.getStatusIcon(svc.Status)
 	}
 
+	// Failure category tag, e.g. "timeout"
+	reasonTag := ""
+	if svc.Status == monitor.StatusUnhealthy && svc.Reason != monitor.ReasonNone && !svc.Paused && !svc.IsChecking {
+		reasonTag = " " + m.renderFailureReason(svc.Reason)
+	}
+	if svc.RateLimited && !svc.Paused {
+		reasonTag += " " + checkingStyle.Render("[rate-limited]")
+	}
+	if _, snoozed := m.snoozedUntil(svc.Name); snoozed {
+		reasonTag += " " + pausedStyle.Render("[snoozed]")
+	}
+
 	// Service name (truncate if needed)
 	name := svc.Name
-	maxNameLen := width - 6
+	maxNameLen := width - 6 - lipgloss.Width(reasonTag)
 	if len(name) > maxNameLen {
 		name = name[:maxNameLen-1] + "…"
 	}
@@ -384,17 +545,23 @@ func (m Model) renderServiceCompact(svc ServiceState, width int, isSelected bool
 		nameStyle = nameStyle.Underline(true)
 	}
 	headerLine := fmt.Sprintf("%s %s", statusIcon, nameStyle.Render(name))
-	b.WriteString(headerLine)
-	b.WriteString("\n")
+	if activeTheme.StatusLabels && !svc.Paused && !svc.IsChecking {
+		headerLine += m.renderStatusLabel(svc.Status)
+	}
+	headerLine += reasonTag
+	lines := []string{headerLine}
 
 	// Details section
 	// Status code and response time on one line
-	if svc.Paused {
-		b.WriteString(pausedStyle.Render("Paused"))
-		b.WriteString("\n")
-	} else if (svc.StatusCode > 0 || svc.ResponseTime > 0) && !svc.IsChecking {
+	showDetails := m.cardFields[cardFieldStatusCode] || m.cardFields[cardFieldLatency]
+	switch {
+	case svc.Paused:
+		lines = append(lines, pausedStyle.Render("Paused"))
+	case !showDetails:
+		// Decluttered cards skip the state line
+	case (svc.StatusCode > 0 || svc.ResponseTime > 0) && !svc.IsChecking:
 		var details []string
-		if svc.StatusCode > 0 {
+		if svc.StatusCode > 0 && m.cardFields[cardFieldStatusCode] {
 			codeStr := fmt.Sprintf("%d", svc.StatusCode)
 			// Color code based on value
 			var codeColor lipgloss.Color
@@ -407,52 +574,350 @@ func (m Model) renderServiceCompact(svc ServiceState, width int, isSelected bool
 			}
 			details = append(details, lipgloss.NewStyle().Foreground(codeColor).Bold(true).Render(codeStr))
 		}
-		if svc.ResponseTime > 0 {
-			details = append(details, secondaryStyle.Render(m.formatDuration(svc.ResponseTime)))
+		if svc.ResponseTime > 0 && m.cardFields[cardFieldLatency] {
+			details = append(details, secondaryStyle.Render(m.formatDuration(m.cardLatency(svc))))
 		}
 
 		// Join with a dot
 		if len(details) > 0 {
-			b.WriteString(strings.Join(details, secondaryStyle.Render(" • ")))
-			b.WriteString("\n")
+			lines = append(lines, strings.Join(details, secondaryStyle.Render(" • ")))
 		}
-	} else if svc.IsChecking {
-		b.WriteString(secondaryStyle.Render("Checking..."))
-		b.WriteString("\n")
-	} else {
-		b.WriteString(secondaryStyle.Render("Waiting..."))
-		b.WriteString("\n")
+	case svc.IsChecking:
+		lines = append(lines, secondaryStyle.Render("Checking..."))
+	case svc.Status == monitor.StatusPending:
+		lines = append(lines, secondaryStyle.Render("—"))
+	default:
+		lines = append(lines, secondaryStyle.Render("Waiting..."))
+	}
+
+	// Values extracted from the response, e.g. a version
+	if len(svc.Fields) > 0 {
+		values := make([]string, len(svc.Fields))
+		for i, field := range svc.Fields {
+			values[i] = field.Value
+		}
+		line := strings.Join(values, " • ")
+		if runes := []rune(line); len(runes) > width-4 {
+			line = string(runes[:width-5]) + "…"
+		}
+		lines = append(lines, secondaryStyle.Render(line))
+	}
+
+	if m.cardFields[cardFieldLatencyBar] && !svc.Paused {
+		lines = append(lines, m.renderLatencyBar(svc, width-4))
 	}
 
 	// Enabled checks summary
-	if len(svc.Checks) > 0 {
-		checksLine := secondaryStyle.Render("Checks: " + strings.Join(svc.Checks, secondaryStyle.Render(" • ")))
-		b.WriteString(checksLine)
-		b.WriteString("\n")
+	if len(svc.Checks) > 0 && m.cardFields[cardFieldChecks] {
+		lines = append(lines, secondaryStyle.Render("Checks: ")+renderCheckLabels(svc.Checks, svc.CheckResults))
 	}
 
-	// Last checked time (smaller)
-	if !svc.LastChecked.IsZero() && !svc.IsChecking {
-		b.WriteString(lipgloss.NewStyle().Foreground(colorSubtle).Render(m.formatTime(svc.LastChecked)))
+	// Last checked time (smaller), leaving the line blank while checking so
+	// cards keep their height
+	if m.cardFields[cardFieldLastChecked] {
+		lastChecked := ""
+		if !svc.LastChecked.IsZero() && !svc.IsChecking {
+			lastChecked = lipgloss.NewStyle().Foreground(colorSubtle).Render(m.formatTime(svc.LastChecked))
+		}
+		lines = append(lines, lastChecked)
 	}
 
 	// Error if present (truncate to fit)
-	if svc.Error != nil {
-		b.WriteString("\n")
+	if svc.Error != nil && m.cardFields[cardFieldError] {
 		errMsg := svc.Error.Error()
 		if len(errMsg) > width-4 {
 			errMsg = errMsg[:width-7] + "…"
 		}
-		b.WriteString(errorStyle.Render(errMsg))
+		lines = append(lines, errorStyle.Render(errMsg))
 	}
 
-	content := b.String()
+	content := strings.Join(lines, "\n")
 
 	// Apply the dynamic border
+	return m.cardStyle(width).
+		BorderForeground(borderColor).
+		Render(content)
+}
+
+// cardStyle returns the grid card style at the configured density, sized so
+// content gets the same width at every density
+func (m Model) cardStyle(width int) lipgloss.Style {
+	d := m.density
+	return baseCardStyle.
+		Padding(d.padY, d.padX).
+		MarginRight(d.marginRight).
+		MarginBottom(d.marginBottom).
+		Width(width + 2*(d.padX-densities[densityNormal].padX))
+}
+
+// renderGroupCard renders a group's aggregate health against its quorum
+func (m Model) renderGroupCard(group monitor.GroupHealth, width int) string {
+	borderColor, label := groupStatus(group)
+
+	name := group.Name
+	if maxNameLen := width - 6 - lipgloss.Width(label); len(name) > maxNameLen && maxNameLen > 1 {
+		name = name[:maxNameLen-1] + "…"
+	}
+
+	lines := []string{
+		fmt.Sprintf("◆ %s %s", serviceNameStyle.Render(name), label),
+		secondaryStyle.Render(fmt.Sprintf("%d/%d up • quorum %d", group.Up, group.Total, group.Quorum)),
+	}
+
+	return m.cardStyle(width).
+		BorderForeground(borderColor).
+		Render(strings.Join(lines, "\n"))
+}
+
+// groupStatus returns the border color and status label for a group
+func groupStatus(group monitor.GroupHealth) (lipgloss.Color, string) {
+	switch group.Status {
+	case monitor.StatusHealthy:
+		return colorHealthy, healthyStyle.Render("HEALTHY")
+	case monitor.StatusDegraded:
+		return colorChecking, checkingStyle.Render("DEGRADED")
+	case monitor.StatusUnhealthy:
+		return colorUnhealthy, unhealthyStyle.Render("DOWN")
+	default:
+		return colorSubtle, metadataStyle.Render("WAITING")
+	}
+}
+
+// listNameWidth returns the width of the name column in the list layout:
+// the longest service name, up to a third of the terminal
+func (m Model) listNameWidth(width int) int {
+	nameWidth := 8
+	for _, svc := range m.services {
+		nameWidth = max(nameWidth, lipgloss.Width(svc.Name))
+	}
+	return min(nameWidth, max(width/3, 8))
+}
+
+// padRight truncates or pads s to exactly width cells
+func padRight(s string, width int) string {
+	if runes := []rune(s); len(runes) > width {
+		return string(runes[:max(width-1, 0)]) + "…"
+	}
+	return s + strings.Repeat(" ", max(width-lipgloss.Width(s), 0))
+}
+
+// renderServiceRow renders a service as a single line of the list layout:
+// status, name, status code, latency, last checked, and checks
+func (m Model) renderServiceRow(svc ServiceState, width, nameWidth int, isSelected bool) string {
+	icon, iconStyle := m.getStatusIcon(svc.Status), secondaryStyle
+	switch {
+	case svc.Paused:
+		icon, iconStyle = "⏸", pausedStyle
+	case svc.IsChecking:
+		icon, iconStyle = "⟳", checkingStyle
+		if s, exists := m.spinners[svc.Name]; exists {
+			icon = s.View()
+		}
+	case svc.Status == monitor.StatusHealthy:
+		iconStyle = healthyStyle
+	case svc.Status == monitor.StatusUnhealthy:
+		iconStyle = unhealthyStyle
+	case svc.Status == monitor.StatusStalled:
+		iconStyle = checkingStyle
+	}
+
+	marker := "  "
+	nameStyle := serviceNameStyle
+	if isSelected {
+		marker = lipgloss.NewStyle().Foreground(colorAccent).Render("▸ ")
+		nameStyle = nameStyle.Underline(true)
+	}
+
+	code, latency, checked := "—", "—", ""
+	if !svc.IsChecking && !svc.Paused {
+		if svc.StatusCode > 0 {
+			code = fmt.Sprintf("%d", svc.StatusCode)
+		}
+		if svc.ResponseTime > 0 {
+			latency = m.formatDuration(m.cardLatency(svc))
+		}
+		if !svc.LastChecked.IsZero() {
+			checked = m.formatTime(svc.LastChecked)
+		}
+	}
+
+	line := marker + iconStyle.Render(icon) + " " +
+		nameStyle.Render(padRight(svc.Name, nameWidth)) + "  " +
+		secondaryStyle.Render(padRight(code, 4)) +
+		secondaryStyle.Render(padRight(latency, 10)) +
+		metadataStyle.Render(padRight(checked, 17))
+
+	if svc.RateLimited && !svc.Paused {
+		line += checkingStyle.Render("rate-limited ")
+	}
+	if _, snoozed := m.snoozedUntil(svc.Name); snoozed {
+		line += pausedStyle.Render("snoozed ")
+	}
+
+	// Checks fill whatever width remains
+	if remaining := width - lipgloss.Width(line) - 1; remaining > 1 && len(svc.Checks) > 0 {
+		line += secondaryStyle.Render(padRight(strings.Join(svc.Checks, " • "), remaining))
+	}
+	return line
+}
+
+// renderGroupRow renders a group's aggregate health as a line of the list layout
+func (m Model) renderGroupRow(group monitor.GroupHealth, nameWidth int) string {
+	_, label := groupStatus(group)
+	return "  ◆ " + serviceNameStyle.Render(padRight(group.Name, nameWidth)) + "  " + label + " " +
+		secondaryStyle.Render(fmt.Sprintf("%d/%d up • quorum %d", group.Up, group.Total, group.Quorum))
+}
+
+// cardLatency returns the latency shown on a card: the moving average when
+// smooth_latency is set, otherwise the last check's
+func (m Model) cardLatency(svc ServiceState) time.Duration {
+	if m.smoothLatency && svc.AvgLatency > 0 {
+		return svc.AvgLatency
+	}
+	return svc.ResponseTime
+}
+
+// defaultLatencyBarScale is the full-scale latency of a card's latency bar
+// for services without a latency threshold or SLO target
+const defaultLatencyBarScale = time.Second
+
+// renderLatencyBar renders the response time as a bar filled relative to
+// the service's latency threshold (or SLO latency target)
+func (m Model) renderLatencyBar(svc ServiceState, width int) string {
+	scale := defaultLatencyBarScale
+	if cfg := m.getServiceConfig(svc.Name); cfg != nil {
+		if cfg.LatencyThreshold > 0 {
+			scale = time.Duration(cfg.LatencyThreshold) * time.Millisecond
+		} else if cfg.SLO != nil && cfg.SLO.LatencyTarget > 0 {
+			scale = time.Duration(cfg.SLO.LatencyTarget) * time.Millisecond
+		}
+	}
+
+	ratio := 0.0
+	if !svc.IsChecking {
+		ratio = min(float64(m.cardLatency(svc))/float64(scale), 1)
+	}
+	filled := int(ratio * float64(width))
+
+	color := colorHealthy
+	switch {
+	case ratio >= 0.8:
+		color = colorUnhealthy
+	case ratio >= 0.5:
+		color = colorChecking
+	}
+
+	return lipgloss.NewStyle().Foreground(color).Render(strings.Repeat("█", filled)) +
+		lipgloss.NewStyle().Foreground(colorSubtle).Render(strings.Repeat("░", width-filled))
+}
+
+// renderComparison lays out services sharing a group side by side,
+// highlighting the slowest member of each group
+func (m Model) renderComparison(width int) string {
+	groups := make(map[string][]ServiceState)
+	for _, svc := range m.services {
+		if cfg := m.getServiceConfig(svc.Name); cfg != nil && cfg.Group != "" {
+			groups[cfg.Group] = append(groups[cfg.Group], svc)
+		}
+	}
+
+	if len(groups) == 0 {
+		return "\n" + metadataStyle.Render("No grouped services. Add a group to services to compare them (g to return).") + "\n"
+	}
+
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	selected := m.getSelectedName()
+	for _, name := range names {
+		members := groups[name]
+		m.sortServices(members)
+
+		// Find the slowest member with a completed check
+		slowest := ""
+		var slowestTime time.Duration
+		for _, svc := range members {
+			if !svc.IsChecking && !svc.Paused && svc.ResponseTime > slowestTime {
+				slowest = svc.Name
+				slowestTime = svc.ResponseTime
+			}
+		}
+		if len(members) < 2 {
+			slowest = ""
+		}
+
+		cardWidth := (width - 4) / len(members)
+		if cardWidth < 20 {
+			cardWidth = 20
+		}
+
+		var cards []string
+		for _, svc := range members {
+			cards = append(cards, m.renderComparisonCard(svc, cardWidth, svc.Name == selected, svc.Name == slowest))
+		}
+
+		b.WriteString("\n" + headerStyle.Render(fmt.Sprintf("◆ %s (%d)", name, len(members))) + "\n")
+		b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, cards...))
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// renderComparisonCard renders a single service within a comparison row
+func (m Model) renderComparisonCard(svc ServiceState, width int, isSelected, isSlowest bool) string {
+	var b strings.Builder
+
+	statusIcon := m.getStatusIcon(svc.Status)
+	if svc.Paused {
+		statusIcon = "⏸"
+	} else if svc.IsChecking {
+		statusIcon = "⟳"
+	}
+
+	nameStyle := serviceNameStyle
+	if isSelected {
+		nameStyle = nameStyle.Underline(true)
+	}
+	b.WriteString(fmt.Sprintf("%s %s", statusIcon, nameStyle.Render(svc.Name)))
+	if activeTheme.StatusLabels && !svc.Paused && !svc.IsChecking {
+		b.WriteString(m.renderStatusLabel(svc.Status))
+	}
+	b.WriteString("\n")
+
+	latency := "--"
+	if svc.ResponseTime > 0 {
+		latency = m.formatDuration(svc.ResponseTime)
+	}
+	latencyStyle := secondaryStyle
+	if isSlowest {
+		latencyStyle = checkingStyle
+		latency += " ▲ slowest"
+	}
+	b.WriteString(latencyStyle.Render(latency))
+	b.WriteString("\n")
+	b.WriteString(secondaryStyle.Render(string(svc.Status)))
+
+	borderColor := colorSubtle
+	switch {
+	case isSelected:
+		borderColor = colorAccent
+	case isSlowest:
+		borderColor = colorChecking
+	case svc.Status == monitor.StatusHealthy:
+		borderColor = colorHealthy
+	case svc.Status == monitor.StatusUnhealthy:
+		borderColor = colorUnhealthy
+	}
+
 	return baseCardStyle.
 		Width(width).
 		BorderForeground(borderColor).
-		Render(content)
+		Render(b.String())
 }
 
 // renderDetailOverlay shows a modal with detailed info about the selected service
@@ -495,19 +960,48 @@ func (m Model) renderDetailOverlay() string {
 	}
 
 	var b strings.Builder
-	statusLine := fmt.Sprintf("%s %s", m.getStatusIcon(svc.Status), serviceNameStyle.Render(svc.Name))
+	statusIcon := m.getStatusIcon(svc.Status)
+	if s, exists := m.spinners[svc.Name]; exists && svc.IsChecking {
+		statusIcon = s.View()
+	}
+	statusLine := fmt.Sprintf("%s %s", statusIcon, serviceNameStyle.Render(svc.Name))
 	b.WriteString(titleStyle.Render(statusLine))
 	b.WriteString("\n")
 
 	// Status summary
-	b.WriteString(secondaryStyle.Render(fmt.Sprintf("Status: %s", svc.Status)))
+	status := secondaryStyle.Render(fmt.Sprintf("Status: %s", svc.Status))
+	if svc.Status == monitor.StatusUnhealthy && svc.Reason != monitor.ReasonNone {
+		status += " " + m.renderFailureReason(svc.Reason)
+	}
+	if svc.RateLimited {
+		status += " " + checkingStyle.Render("[rate-limited]")
+	}
+	b.WriteString(status)
 	b.WriteString("\n")
+	if until, snoozed := m.snoozedUntil(svc.Name); snoozed {
+		b.WriteString(pausedStyle.Render(fmt.Sprintf("🔕 Notifications snoozed until %s", until.Format("15:04"))))
+		b.WriteString("\n")
+	}
 	if svc.StatusCode > 0 {
 		b.WriteString(secondaryStyle.Render(fmt.Sprintf("Status Code: %d", svc.StatusCode)))
 		b.WriteString("\n")
 	}
+	if m.monitor != nil {
+		if codes := formatStatusCodes(monitor.StatusCodeBreakdown(m.monitor.History(svc.Name), statusCodeWindow)); codes != "" {
+			b.WriteString(secondaryStyle.Render(fmt.Sprintf("Codes (last %d): %s", statusCodeWindow, codes)))
+			b.WriteString("\n")
+		}
+	}
 	if svc.ResponseTime > 0 {
-		b.WriteString(secondaryStyle.Render(fmt.Sprintf("Latency: %s", m.formatDuration(svc.ResponseTime))))
+		latency := fmt.Sprintf("Latency: %s", m.formatDuration(svc.ResponseTime))
+		if svc.AvgLatency > 0 {
+			latency += fmt.Sprintf(" (avg %s)", m.formatDuration(svc.AvgLatency))
+		}
+		b.WriteString(secondaryStyle.Render(latency))
+		b.WriteString("\n")
+	}
+	if timing := m.formatTiming(svc.Timing); timing != "" {
+		b.WriteString(secondaryStyle.Render("Timing: " + timing))
 		b.WriteString("\n")
 	}
 	if !svc.LastChecked.IsZero() {
@@ -522,6 +1016,48 @@ func (m Model) renderDetailOverlay() string {
 		b.WriteString(errorStyle.Render(fmt.Sprintf("Error: %v", svc.Error)))
 		b.WriteString("\n")
 	}
+	if m.monitor != nil {
+		if incident, ok := m.monitor.CurrentIncident(svc.Name); ok {
+			b.WriteString(errorStyle.Render(fmt.Sprintf("Down for: %s (since %s)", formatDowntime(incident.Duration(time.Now())), m.formatTime(incident.Started))))
+			b.WriteString("\n")
+		} else if incident, ok := m.monitor.LastIncident(svc.Name); ok {
+			b.WriteString(secondaryStyle.Render(fmt.Sprintf("Last incident: %s, recovered %s", formatDowntime(incident.Duration(incident.Ended)), m.formatTime(incident.Ended))))
+			b.WriteString("\n")
+		}
+	}
+
+	// Extracted response values
+	if len(svc.Fields) > 0 {
+		b.WriteString("\n")
+		b.WriteString(headerStyle.Render("Fields"))
+		b.WriteString("\n")
+		for _, field := range svc.Fields {
+			b.WriteString(secondaryStyle.Render(fmt.Sprintf("%s: %s", field.Path, field.Value)))
+			b.WriteString("\n")
+		}
+	}
+
+	// Every JSON assertion's outcome, failures highlighted
+	if len(svc.Assertions) > 0 {
+		b.WriteString("\n")
+		b.WriteString(headerStyle.Render("Assertions (" + monitor.AssertionSummary(svc.Assertions) + ")"))
+		b.WriteString("\n")
+		for _, a := range svc.Assertions {
+			b.WriteString(m.renderAssertionResult(a))
+			b.WriteString("\n")
+		}
+	}
+
+	// Endpoint sub-checks
+	if len(svc.Endpoints) > 0 {
+		b.WriteString("\n")
+		b.WriteString(headerStyle.Render("Endpoints"))
+		b.WriteString("\n")
+		for _, endpoint := range svc.Endpoints {
+			b.WriteString(m.renderEndpointResult(endpoint))
+			b.WriteString("\n")
+		}
+	}
 
 	// Config info
 	if cfg != nil {
@@ -529,15 +1065,24 @@ func (m Model) renderDetailOverlay() string {
 		b.WriteString(headerStyle.Render("Configuration"))
 		b.WriteString("\n")
 		if cfg.URL != "" {
-			b.WriteString(secondaryStyle.Render("URL: " + cfg.URL))
+			b.WriteString(secondaryStyle.Render("URL: " + monitor.RedactURL(cfg.URL)))
 			b.WriteString("\n")
 		}
 		if cfg.HealthEndpoint != "" {
 			b.WriteString(secondaryStyle.Render("Endpoint: " + cfg.HealthEndpoint))
 			b.WriteString("\n")
 		}
-		if cfg.Type != "" {
-			b.WriteString(secondaryStyle.Render("Type: " + cfg.Type))
+		checkerType := cfg.CheckerType()
+		if checkerType == "http" || checkerType == "reachable" || checkerType == "latency" {
+			if requestURL, err := monitor.RequestURL(*cfg); err == nil {
+				b.WriteString(secondaryStyle.Render("Request URL: " + requestURL))
+			} else {
+				b.WriteString(errorStyle.Render(fmt.Sprintf("Request URL: %v", err)))
+			}
+			b.WriteString("\n")
+		}
+		if cfg.Type != "" || checkerType != "http" {
+			b.WriteString(secondaryStyle.Render("Type: " + checkerType))
 			b.WriteString("\n")
 		}
 		if len(cfg.Headers) > 0 {
@@ -558,14 +1103,23 @@ func (m Model) renderDetailOverlay() string {
 			labels = m.buildCheckLabels(*cfg)
 		}
 		if len(labels) > 0 {
-			b.WriteString(secondaryStyle.Render("Checks: " + strings.Join(labels, " • ")))
+			b.WriteString(secondaryStyle.Render("Checks: ") + renderCheckLabels(labels, svc.CheckResults))
+			b.WriteString("\n")
+		}
+		if cfg.SLO != nil {
+			report := monitor.EvaluateSLO(*cfg.SLO, m.monitor.History(svc.Name))
+			style := secondaryStyle
+			if report.Samples > 0 && !report.Met() {
+				style = errorStyle
+			}
+			b.WriteString(style.Render("SLO: " + report.String()))
 			b.WriteString("\n")
 		}
 	}
 
 	// Footer hint
 	b.WriteString("\n")
-	b.WriteString(metadataStyle.Render("Enter/Esc to close"))
+	b.WriteString(metadataStyle.Render("r to re-check • Enter/Esc to close"))
 
 	card := baseCardStyle.
 		BorderForeground(colorAccent).
@@ -581,6 +1135,32 @@ func (m Model) renderDetailOverlay() string {
 	)
 }
 
+// renderStatusLabel renders a textual UP/DOWN label for a status
+func (m Model) renderStatusLabel(status monitor.Status) string {
+	label := statusLabel(status)
+	switch status {
+	case monitor.StatusHealthy:
+		return " " + healthyStyle.Render(label)
+	case monitor.StatusUnhealthy:
+		return " " + unhealthyStyle.Render(label)
+	case monitor.StatusStalled:
+		return " " + checkingStyle.Render(label)
+	default:
+		return ""
+	}
+}
+
+// renderFailureReason renders a failure category as a colored tag;
+// connectivity failures are red and failures of the response itself amber
+func (m Model) renderFailureReason(reason monitor.FailureReason) string {
+	style := checkingStyle
+	switch reason {
+	case monitor.ReasonDNS, monitor.ReasonConnect, monitor.ReasonTLS, monitor.ReasonTimeout:
+		style = unhealthyStyle
+	}
+	return style.Render("[" + string(reason) + "]")
+}
+
 // getStatusIcon returns the icon for a status
 func (m Model) getStatusIcon(status monitor.Status) string {
 	switch status {
@@ -590,13 +1170,24 @@ func (m Model) getStatusIcon(status monitor.Status) string {
 		return "✗"
 	case monitor.StatusChecking:
 		return "●"
+	case monitor.StatusStalled:
+		return "⧗"
+	case monitor.StatusPending:
+		return "◌"
 	default:
 		return "?"
 	}
 }
 
-// formatDuration formats a duration for display
+// formatDuration formats a duration for display using the configured unit
 func (m Model) formatDuration(d time.Duration) string {
+	switch m.latencyUnit {
+	case latencyUnitMs:
+		return fmt.Sprintf("%.*fms", m.precisionOr(1), float64(d.Microseconds())/1000)
+	case latencyUnitS:
+		return fmt.Sprintf("%.*fs", m.precisionOr(2), d.Seconds())
+	}
+
 	if d < time.Millisecond {
 		return fmt.Sprintf("%dµs", d.Microseconds())
 	}
@@ -606,6 +1197,172 @@ func (m Model) formatDuration(d time.Duration) string {
 	return fmt.Sprintf("%.2fs", d.Seconds())
 }
 
+// renderHelpOverlay lists every key binding, grouped by purpose
+func (m Model) renderHelpOverlay() string {
+	width := m.width
+	height := m.height
+	if width < 60 {
+		width = 60
+	}
+	if height < 20 {
+		height = 20
+	}
+
+	// Align descriptions on the widest key label
+	keyWidth := 0
+	for _, section := range keys.FullHelp() {
+		for _, binding := range section.bindings {
+			keyWidth = max(keyWidth, lipgloss.Width(binding.Help().Key))
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("Keyboard Shortcuts"))
+	b.WriteString("\n")
+	for _, section := range keys.FullHelp() {
+		b.WriteString("\n")
+		b.WriteString(headerStyle.Render(section.title))
+		b.WriteString("\n")
+		for _, binding := range section.bindings {
+			help := binding.Help()
+			padding := strings.Repeat(" ", keyWidth-lipgloss.Width(help.Key))
+			b.WriteString(serviceNameStyle.Render(help.Key) + padding + "  " + secondaryStyle.Render(help.Desc))
+			b.WriteString("\n")
+		}
+	}
+
+	// Footer hint
+	b.WriteString("\n")
+	b.WriteString(metadataStyle.Render("? or Esc to close"))
+
+	card := baseCardStyle.
+		BorderForeground(colorAccent).
+		Width(min(width-10, 60)).
+		Render(b.String())
+
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		card,
+	)
+}
+
+// statusCodeWindow is how many recent results the status code breakdown covers
+const statusCodeWindow = 100
+
+// formatStatusCodes formats a status code breakdown, e.g.
+// "200: 83% • 503: 12% • 502: 5%", or "" when no check got a response
+func formatStatusCodes(shares []monitor.StatusCodeShare) string {
+	parts := make([]string, 0, len(shares))
+	responded := false
+	for _, share := range shares {
+		code := "none"
+		if share.Code > 0 {
+			code = strconv.Itoa(share.Code)
+			responded = true
+		}
+		parts = append(parts, fmt.Sprintf("%s: %.0f%%", code, share.Percent))
+	}
+	if !responded {
+		return ""
+	}
+	return strings.Join(parts, " • ")
+}
+
+// renderCheckLabels joins check labels, coloring each by its latest outcome
+// and leaving checks that haven't run muted
+func renderCheckLabels(labels []string, results map[string]bool) string {
+	rendered := make([]string, len(labels))
+	for i, label := range labels {
+		passed, ran := results[label]
+		switch {
+		case !ran:
+			rendered[i] = secondaryStyle.Render(label)
+		case passed:
+			rendered[i] = lipgloss.NewStyle().Foreground(colorHealthy).Render(label)
+		default:
+			rendered[i] = lipgloss.NewStyle().Foreground(colorUnhealthy).Render(label)
+		}
+	}
+	return strings.Join(rendered, secondaryStyle.Render(" • "))
+}
+
+// renderAssertionResult renders one JSON assertion's outcome
+func (m Model) renderAssertionResult(a monitor.AssertionResult) string {
+	if a.Passed {
+		line := fmt.Sprintf("%s %s %s %v", m.getStatusIcon(monitor.StatusHealthy), a.Assertion.Path, a.Assertion.Operator, a.Assertion.Value)
+		return secondaryStyle.Render(line)
+	}
+	return errorStyle.Render(fmt.Sprintf("%s %v", m.getStatusIcon(monitor.StatusUnhealthy), a.Error))
+}
+
+// renderEndpointResult formats one endpoint sub-check, e.g.
+// "✗ /ready 503 120ms: Expected 200, got 503"
+func (m Model) renderEndpointResult(endpoint monitor.EndpointResult) string {
+	line := fmt.Sprintf("%s %s", m.getStatusIcon(endpoint.Status), endpoint.Path)
+	if endpoint.StatusCode > 0 {
+		line += fmt.Sprintf(" %d", endpoint.StatusCode)
+	}
+	if endpoint.ResponseTime > 0 {
+		line += " " + m.formatDuration(endpoint.ResponseTime)
+	}
+
+	if endpoint.Status == monitor.StatusHealthy {
+		return secondaryStyle.Render(line)
+	}
+
+	detail := endpoint.Message
+	if endpoint.Error != nil {
+		detail = endpoint.Error.Error()
+	}
+	if detail != "" {
+		line += ": " + detail
+	}
+	return errorStyle.Render(line)
+}
+
+// formatTiming formats the recorded connection phases, e.g.
+// "DNS 12ms • Connect 30ms • TLS 2.1s • TTFB 2.3s"
+func (m Model) formatTiming(timing *monitor.Timing) string {
+	if timing == nil {
+		return ""
+	}
+
+	var parts []string
+	for _, phase := range []struct {
+		name string
+		d    time.Duration
+	}{
+		{"DNS", timing.DNS},
+		{"Connect", timing.Connect},
+		{"TLS", timing.TLS},
+		{"TTFB", timing.TTFB},
+	} {
+		if phase.d > 0 {
+			parts = append(parts, phase.name+" "+m.formatDuration(phase.d))
+		}
+	}
+	return strings.Join(parts, " • ")
+}
+
+// precisionOr returns the configured latency precision, or fallback if unset
+func (m Model) precisionOr(fallback int) int {
+	if m.latencyPrecision < 0 {
+		return fallback
+	}
+	return m.latencyPrecision
+}
+
+// formatDowntime formats an incident's duration to the second
+func formatDowntime(d time.Duration) string {
+	if d < time.Second {
+		return "<1s"
+	}
+	return d.Round(time.Second).String()
+}
+
 // formatTime formats a time for display
 func (m Model) formatTime(t time.Time) string {
 	now := time.Now()
@@ -621,6 +1378,144 @@ func (m Model) formatTime(t time.Time) string {
 	return t.Format("15:04:05")
 }
 
+// captureSize returns the scrollable area of the capture pane
+func (m Model) paneSize() (int, int) {
+	return max(m.width-14, 40), max(m.height-10, 10)
+}
+
+// renderCaptureContent renders the captured request and response for a service
+func (m Model) renderCaptureContent(name string) string {
+	width, _ := m.paneSize()
+
+	capture, ok := m.monitor.LastCapture(name)
+	if !ok {
+		hint := "No response captured yet."
+		if cfg := m.getServiceConfig(name); cfg == nil || !cfg.Capture {
+			hint += " Set capture: true on this service to retain responses."
+		}
+		return metadataStyle.Width(width).Render(hint)
+	}
+
+	var b strings.Builder
+	b.WriteString(secondaryStyle.Render(fmt.Sprintf("%s %s", capture.Method, capture.URL)))
+	b.WriteString("\n")
+	b.WriteString(secondaryStyle.Render(fmt.Sprintf("Status Code: %d", capture.StatusCode)))
+	b.WriteString("\n")
+	b.WriteString(secondaryStyle.Render(fmt.Sprintf("Captured: %s", m.formatTime(capture.CapturedAt))))
+	b.WriteString("\n\n")
+
+	b.WriteString(headerStyle.Render("Headers"))
+	b.WriteString("\n")
+	keys := make([]string, 0, len(capture.Headers))
+	for key := range capture.Headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		b.WriteString(fmt.Sprintf("%s: %s\n", key, strings.Join(capture.Headers[key], ", ")))
+	}
+
+	b.WriteString("\n")
+	b.WriteString(headerStyle.Render("Body"))
+	b.WriteString("\n")
+	if capture.Body == "" {
+		b.WriteString(metadataStyle.Render("(empty)"))
+	} else {
+		b.WriteString(capture.Body)
+	}
+	if capture.Truncated {
+		b.WriteString("\n")
+		b.WriteString(metadataStyle.Render(fmt.Sprintf("… truncated to %d bytes", monitor.MaxCaptureBytes)))
+	}
+
+	return lipgloss.NewStyle().Width(width).Render(b.String())
+}
+
+// renderCaptureOverlay shows a scrollable pane with the last captured response
+func (m Model) renderCaptureOverlay() string {
+	var b strings.Builder
+
+	b.WriteString(titleStyle.Render(fmt.Sprintf("Last Response: %s", serviceNameStyle.Render(m.captureName))))
+	b.WriteString("\n")
+	b.WriteString(m.captureView.View())
+	b.WriteString("\n\n")
+	b.WriteString(metadataStyle.Render(fmt.Sprintf("↑/↓ to scroll (%3.f%%) • Enter/Esc to close", m.captureView.ScrollPercent()*100)))
+
+	width, _ := m.paneSize()
+	card := baseCardStyle.
+		BorderForeground(colorAccent).
+		Width(width + 4).
+		Render(b.String())
+
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		card,
+	)
+}
+
+// renderEventsContent renders recent status transitions, newest first
+func (m Model) renderEventsContent() string {
+	width, _ := m.paneSize()
+
+	transitions := m.monitor.Transitions()
+	if len(transitions) == 0 {
+		return metadataStyle.Width(width).Render("No status changes yet.")
+	}
+
+	var b strings.Builder
+	for _, transition := range transitions {
+		toStyle := secondaryStyle
+		switch transition.To {
+		case monitor.StatusHealthy:
+			toStyle = healthyStyle
+		case monitor.StatusUnhealthy:
+			toStyle = unhealthyStyle
+		}
+
+		line := fmt.Sprintf("%s  %s  %s → %s",
+			metadataStyle.Render(transition.At.Format("15:04:05")),
+			serviceNameStyle.Render(transition.ServiceName),
+			secondaryStyle.Render(string(transition.From)),
+			toStyle.Render(string(transition.To)),
+		)
+		if transition.Message != "" {
+			line += "  " + metadataStyle.Render(transition.Message)
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+
+	return lipgloss.NewStyle().Width(width).Render(strings.TrimSuffix(b.String(), "\n"))
+}
+
+// renderEventsOverlay shows a scrollable feed of recent status transitions
+func (m Model) renderEventsOverlay() string {
+	var b strings.Builder
+
+	b.WriteString(titleStyle.Render("Recent Events"))
+	b.WriteString("\n")
+	b.WriteString(m.eventsView.View())
+	b.WriteString("\n\n")
+	b.WriteString(metadataStyle.Render(fmt.Sprintf("↑/↓ to scroll (%3.f%%) • Enter/Esc to close", m.eventsView.ScrollPercent()*100)))
+
+	width, _ := m.paneSize()
+	card := baseCardStyle.
+		BorderForeground(colorAccent).
+		Width(width + 4).
+		Render(b.String())
+
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		card,
+	)
+}
+
 // renderErrorDetailOverlay shows a modal with detailed error information
 func (m Model) renderErrorDetailOverlay() string {
 	width := m.width
@@ -693,7 +1588,7 @@ func (m Model) renderErrorDetailOverlay() string {
 		b.WriteString("\n")
 		b.WriteString(headerStyle.Render("Service Configuration"))
 		b.WriteString("\n")
-		b.WriteString(secondaryStyle.Render(fmt.Sprintf("URL: %s", cfg.URL)))
+		b.WriteString(secondaryStyle.Render(fmt.Sprintf("URL: %s", monitor.RedactURL(cfg.URL))))
 		b.WriteString("\n")
 		if cfg.HealthEndpoint != "" {
 			b.WriteString(secondaryStyle.Render(fmt.Sprintf("Health Endpoint: %s", cfg.HealthEndpoint)))
@@ -732,13 +1627,9 @@ func (m Model) generateCurlCommand(serviceName string) string {
 		return ""
 	}
 
-	url := cfg.URL
-	if cfg.HealthEndpoint != "" {
-		// Append health endpoint to URL
-		if !strings.HasSuffix(url, "/") && !strings.HasPrefix(cfg.HealthEndpoint, "/") {
-			url += "/"
-		}
-		url += cfg.HealthEndpoint
+	url, err := monitor.RequestURL(*cfg)
+	if err != nil {
+		return ""
 	}
 
 	method := cfg.Method
@@ -773,3 +1664,15 @@ func (m Model) generateCurlCommand(serviceName string) string {
 
 	return strings.Join(parts, " ")
 }
+
+// RenderSnapshot renders a single dashboard frame for a set of results
+// without starting the interactive program
+func RenderSnapshot(mon *monitor.Monitor, results []monitor.Result, width int) string {
+	// The height is left unset so a snapshot includes every service
+	model := NewModel(mon, nil)
+	model.width = width
+	for _, result := range results {
+		model.updateServiceState(result)
+	}
+	return model.View()
+}
@@ -0,0 +1,74 @@
+// Package scout exposes Scout's checking engine for embedding in other Go
+// programs, without the CLI or dashboard.
+//
+//	cfg := scout.NewConfig(scout.Service{Name: "api", URL: "https://api.example.com/health"})
+//	results, err := scout.RunOnce(ctx, cfg)
+//
+// The types are the same ones the scout command uses, so configs parsed
+// from a Scout YAML file behave identically here.
+package scout
+
+import (
+	"context"
+
+	"github.com/juststeveking/scout/internal/config"
+	"github.com/juststeveking/scout/internal/monitor"
+)
+
+// Configuration types
+type (
+	Config        = config.Config
+	Service       = config.Service
+	Endpoint      = config.Endpoint
+	Auth          = config.Auth
+	JSONAssertion = config.JSONAssertion
+	SLO           = config.SLO
+	Notifications = config.Notifications
+)
+
+// Checking types
+type (
+	Monitor       = monitor.Monitor
+	Checker       = monitor.Checker
+	Result        = monitor.Result
+	Status        = monitor.Status
+	FailureReason = monitor.FailureReason
+)
+
+// Check statuses
+const (
+	StatusHealthy   = monitor.StatusHealthy
+	StatusUnhealthy = monitor.StatusUnhealthy
+	StatusUnknown   = monitor.StatusUnknown
+	StatusChecking  = monitor.StatusChecking
+)
+
+// NewConfig returns a config for services with Scout's default interval,
+// timeout, and retries. Desktop notifications are disabled.
+func NewConfig(services ...Service) *Config {
+	notifications := false
+	return &Config{
+		CheckInterval: config.DefaultCheckInterval,
+		Timeout:       config.DefaultTimeout,
+		RetryAttempts: config.DefaultRetryAttempts,
+		Notifications: Notifications{Enabled: &notifications},
+		Services:      services,
+	}
+}
+
+// ParseConfig parses a Scout YAML config file's contents
+func ParseConfig(data []byte) (*Config, error) {
+	return config.ParseConfig(data)
+}
+
+// NewMonitor creates a monitor for continuous checking; call Start to begin
+// and read Results for each check
+func NewMonitor(cfg *Config) (*Monitor, error) {
+	return monitor.NewMonitor(cfg)
+}
+
+// RunOnce checks every enabled service in cfg once, concurrently and without
+// retries or notifications, returning results in config order
+func RunOnce(ctx context.Context, cfg *Config) ([]Result, error) {
+	return monitor.RunOnce(ctx, cfg)
+}
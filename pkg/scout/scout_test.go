@@ -0,0 +1,70 @@
+package scout
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRunOnce(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/health" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	disabled := false
+	cfg := NewConfig(
+		Service{Name: "healthy", URL: ts.URL, HealthEndpoint: "/health"},
+		Service{Name: "failing", URL: ts.URL, HealthEndpoint: "/ready"},
+		Service{Name: "disabled", URL: ts.URL, Enabled: &disabled},
+	)
+
+	results, err := RunOnce(context.Background(), cfg)
+	if err != nil {
+		t.Fatalf("RunOnce failed: %v", err)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("Expected results for the 2 enabled services, got %d", len(results))
+	}
+	if results[0].ServiceName != "healthy" || results[0].Status != StatusHealthy {
+		t.Errorf("Expected healthy first result, got %s: %v", results[0].ServiceName, results[0].Status)
+	}
+	if results[1].ServiceName != "failing" || results[1].Status != StatusUnhealthy {
+		t.Errorf("Expected unhealthy second result, got %s: %v", results[1].ServiceName, results[1].Status)
+	}
+}
+
+func TestRunOnceInvalidConfig(t *testing.T) {
+	cfg := NewConfig(Service{Name: "api", URL: "http://api.example.com"})
+	cfg.Timeout = "soon"
+
+	if _, err := RunOnce(context.Background(), cfg); err == nil {
+		t.Error("Expected error for invalid timeout")
+	}
+}
+
+func TestParseConfig(t *testing.T) {
+	cfg, err := ParseConfig([]byte(`
+timeout: 2s
+services:
+  - name: api
+    url: https://api.example.com
+    health_endpoint: /health
+`))
+	if err != nil {
+		t.Fatalf("ParseConfig failed: %v", err)
+	}
+	if cfg.Timeout != "2s" || len(cfg.Services) != 1 || cfg.Services[0].HealthEndpoint != "/health" {
+		t.Errorf("Unexpected config: %+v", cfg)
+	}
+
+	if _, err := ParseConfig([]byte("services: [")); err == nil {
+		t.Error("Expected error for invalid YAML")
+	}
+}